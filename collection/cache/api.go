@@ -0,0 +1,29 @@
+// Package cache provides size-bounded and expiring cache implementations
+package cache
+
+import "time"
+
+// NewLRU creates a cache that evicts the least recently used element when the capacity is exceeded.
+// A non-positive capacity means that the cache is unbounded.
+func NewLRU[K comparable, V any](capacity int) *Cache[K, V] {
+	return &Cache[K, V]{capacity: capacity, policy: &lru[K, V]{}}
+}
+
+// NewLFU creates a cache that evicts the least frequently used element when the capacity is exceeded.
+// Elements with equal usage frequency are evicted in the least recently used order.
+// A non-positive capacity means that the cache is unbounded.
+func NewLFU[K comparable, V any](capacity int) *Cache[K, V] {
+	return &Cache[K, V]{capacity: capacity, policy: &lfu[K, V]{}}
+}
+
+// NewTTL creates a cache that expires an element after the 'ttl' duration since it was set.
+// The least recently used element is evicted when the capacity is exceeded.
+// A non-positive capacity means that the cache is unbounded.
+func NewTTL[K comparable, V any](capacity int, ttl time.Duration) *Cache[K, V] {
+	return NewTTLClock[K, V](capacity, ttl, time.Now)
+}
+
+// NewTTLClock creates a TTL cache that uses the 'clock' function to retrieve the current time.
+func NewTTLClock[K comparable, V any](capacity int, ttl time.Duration, clock func() time.Time) *Cache[K, V] {
+	return &Cache[K, V]{capacity: capacity, policy: &lru[K, V]{}, ttl: ttl, clock: clock}
+}
@@ -0,0 +1,334 @@
+package cache
+
+import (
+	"container/list"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/internal/json_"
+)
+
+// ErrLoaderPanic is returned by GetOrLoad to the callers that waited for a loader which panicked
+var ErrLoaderPanic = errors.New("cache: loader panicked")
+
+// Cache is a concurrency-safe key/value cache with an eviction policy defined by its constructor.
+// The zero value is an unbounded LRU cache.
+type Cache[K comparable, V any] struct {
+	mu       sync.Mutex
+	entries  map[K]*entry[K, V]
+	recency  *list.List
+	policy   policy[K, V]
+	capacity int
+	ttl      time.Duration
+	clock    func() time.Time
+	onEvict  func(K, V)
+	evicted  []*entry[K, V]
+	loads    map[K]*load[V]
+}
+
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+	elem    *list.Element
+	freq    int
+	bucket  *list.Element
+}
+
+type load[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+	// overridden is set when a value is put for the key while loading, so the loaded one is not stored
+	overridden bool
+}
+
+var (
+	_ c.Access[int, any]    = (*Cache[int, any])(nil)
+	_ c.Settable[int, any]  = (*Cache[int, any])(nil)
+	_ c.Removable[int, any] = (*Cache[int, any])(nil)
+	_ c.Deleteable[int]     = (*Cache[int, any])(nil)
+	_ c.Checkable[int]      = (*Cache[int, any])(nil)
+	_ c.KVRange[int, any]   = (*Cache[int, any])(nil)
+	_ json.Marshaler        = (*Cache[int, any])(nil)
+	_ json.Unmarshaler      = (*Cache[int, any])(nil)
+)
+
+// OnEvict registers the 'callback' function that is called for every element evicted because of the capacity or expiration.
+// The callback is called outside of the cache lock, so it may access the cache.
+func (m *Cache[K, V]) OnEvict(callback func(key K, value V)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onEvict = callback
+}
+
+// All is used to iterate through the elements from the most recently used to the least recently used one using `for key, val := range`.
+// The iteration runs over a snapshot and does not affect the recency of the elements.
+func (m *Cache[K, V]) All(consumer func(K, V) bool) {
+	m.lock()
+	snapshot := make([]*entry[K, V], 0, len(m.entries))
+	for elem := m.recency.Front(); elem != nil; elem = elem.Next() {
+		if e := elem.Value.(*entry[K, V]); !m.expired(e) {
+			snapshot = append(snapshot, &entry[K, V]{key: e.key, value: e.value})
+		}
+	}
+	m.unlock()
+	for _, e := range snapshot {
+		if !consumer(e.key, e.value) {
+			return
+		}
+	}
+}
+
+// Len returns amount of the not expired elements
+func (m *Cache[K, V]) Len() int {
+	m.lock()
+	defer m.unlock()
+	m.purge()
+	return len(m.entries)
+}
+
+// IsEmpty returns true if the cache is empty
+func (m *Cache[K, V]) IsEmpty() bool {
+	return m.Len() == 0
+}
+
+// Contains checks is the cache contains a key. The recency of the element is not affected.
+func (m *Cache[K, V]) Contains(key K) bool {
+	m.lock()
+	defer m.unlock()
+	_, ok := m.lookup(key)
+	return ok
+}
+
+// Get returns the value for a key and marks the element as recently used.
+// If ok==false, then the cache does not contain the key.
+func (m *Cache[K, V]) Get(key K) (value V, ok bool) {
+	m.lock()
+	defer m.unlock()
+	if e, ok := m.lookup(key); ok {
+		m.access(e)
+		return e.value, true
+	}
+	return value, false
+}
+
+// Peek returns the value for a key without affecting the recency of the element.
+// If ok==false, then the cache does not contain the key.
+func (m *Cache[K, V]) Peek(key K) (value V, ok bool) {
+	m.lock()
+	defer m.unlock()
+	if e, ok := m.lookup(key); ok {
+		return e.value, true
+	}
+	return value, false
+}
+
+// GetOrLoad returns the value for a key or loads it by the 'loader' function and puts it into the cache.
+// Concurrent calls for the same absent key wait for the result of a single loader call.
+// Loader errors are not cached. A value set for the key while loading is not overwritten by the loaded one.
+func (m *Cache[K, V]) GetOrLoad(key K, loader func(K) (V, error)) (V, error) {
+	m.lock()
+	if e, ok := m.lookup(key); ok {
+		m.access(e)
+		value := e.value
+		m.unlock()
+		return value, nil
+	}
+	if l, ok := m.loads[key]; ok {
+		m.unlock()
+		<-l.done
+		return l.value, l.err
+	}
+	l := &load[V]{done: make(chan struct{}), err: ErrLoaderPanic}
+	if m.loads == nil {
+		m.loads = map[K]*load[V]{}
+	}
+	m.loads[key] = l
+	m.unlock()
+
+	defer func() {
+		m.lock()
+		delete(m.loads, key)
+		if l.err == nil && !l.overridden {
+			m.set(key, l.value)
+		}
+		m.unlock()
+		close(l.done)
+	}()
+	l.value, l.err = loader(key)
+	return l.value, l.err
+}
+
+// Set sets the value for a key and marks the element as recently used.
+// If the capacity is exceeded, then an element is evicted according to the cache policy.
+func (m *Cache[K, V]) Set(key K, value V) {
+	m.lock()
+	defer m.unlock()
+	m.set(key, value)
+}
+
+// Remove removes value by key and return it. The eviction callback is not called.
+func (m *Cache[K, V]) Remove(key K) (value V, ok bool) {
+	m.lock()
+	defer m.unlock()
+	if e, ok := m.lookup(key); ok {
+		m.remove(e)
+		return e.value, true
+	}
+	return value, false
+}
+
+// Delete removes values by their keys from the cache
+func (m *Cache[K, V]) Delete(keys ...K) {
+	for _, key := range keys {
+		m.DeleteOne(key)
+	}
+}
+
+// DeleteOne removes a value by the key from the cache
+func (m *Cache[K, V]) DeleteOne(key K) {
+	_, _ = m.Remove(key)
+}
+
+// Clear removes all elements from the cache without calling the eviction callback
+func (m *Cache[K, V]) Clear() {
+	m.lock()
+	defer m.unlock()
+	clear(m.entries)
+	m.recency.Init()
+	m.policy.clear()
+}
+
+func (m *Cache[K, V]) lock() {
+	m.mu.Lock()
+	if m.entries == nil {
+		m.entries = map[K]*entry[K, V]{}
+		m.recency = list.New()
+	}
+	if m.policy == nil {
+		m.policy = &lru[K, V]{}
+	}
+}
+
+// unlock releases the cache lock and then notifies the eviction callback
+func (m *Cache[K, V]) unlock() {
+	evicted, onEvict := m.evicted, m.onEvict
+	m.evicted = nil
+	m.mu.Unlock()
+	if onEvict != nil {
+		for _, e := range evicted {
+			onEvict(e.key, e.value)
+		}
+	}
+}
+
+func (m *Cache[K, V]) lookup(key K) (*entry[K, V], bool) {
+	e, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	} else if m.expired(e) {
+		m.evict(e)
+		return nil, false
+	}
+	return e, true
+}
+
+func (m *Cache[K, V]) set(key K, value V) {
+	if l, ok := m.loads[key]; ok {
+		l.overridden = true
+	}
+	if e, ok := m.entries[key]; ok {
+		e.value = value
+		e.expires = m.expiration()
+		m.access(e)
+		return
+	}
+	if m.capacity > 0 && len(m.entries) >= m.capacity {
+		m.purge()
+		for len(m.entries) >= m.capacity {
+			m.evict(m.policy.victim(m.recency))
+		}
+	}
+	e := &entry[K, V]{key: key, value: value, expires: m.expiration()}
+	e.elem = m.recency.PushFront(e)
+	m.entries[key] = e
+	m.policy.add(e)
+}
+
+func (m *Cache[K, V]) access(e *entry[K, V]) {
+	m.recency.MoveToFront(e.elem)
+	m.policy.access(e)
+}
+
+func (m *Cache[K, V]) remove(e *entry[K, V]) {
+	delete(m.entries, e.key)
+	m.recency.Remove(e.elem)
+	m.policy.remove(e)
+}
+
+func (m *Cache[K, V]) evict(e *entry[K, V]) {
+	m.remove(e)
+	m.evicted = append(m.evicted, e)
+}
+
+// purge evicts all expired elements
+func (m *Cache[K, V]) purge() {
+	if m.ttl <= 0 {
+		return
+	}
+	for elem := m.recency.Front(); elem != nil; {
+		e := elem.Value.(*entry[K, V])
+		elem = elem.Next()
+		if m.expired(e) {
+			m.evict(e)
+		}
+	}
+}
+
+func (m *Cache[K, V]) expired(e *entry[K, V]) bool {
+	return m.ttl > 0 && !m.now().Before(e.expires)
+}
+
+func (m *Cache[K, V]) expiration() time.Time {
+	if m.ttl <= 0 {
+		return time.Time{}
+	}
+	return m.now().Add(m.ttl)
+}
+
+func (m *Cache[K, V]) now() time.Time {
+	if m.clock == nil {
+		return time.Now()
+	}
+	return m.clock()
+}
+
+// MarshalJSON encodes a snapshot of the not expired elements as a JSON object sorted by the keys
+func (m *Cache[K, V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalSortedObject(m.All)
+}
+
+// UnmarshalJSON replaces the cache elements by the members of a JSON object under the cache lock.
+// The members are set in their order, so the capacity is respected and the last member becomes the most recently used one.
+func (m *Cache[K, V]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	pairs, err := json_.UnmarshalPairs[K, V](data)
+	if err != nil {
+		return err
+	}
+	m.lock()
+	defer m.unlock()
+	clear(m.entries)
+	m.recency.Init()
+	m.policy.clear()
+	for _, kv := range pairs {
+		m.set(kv.K, kv.V)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package cache
+
+import "container/list"
+
+// policy chooses the element to evict when the cache capacity is exceeded.
+type policy[K comparable, V any] interface {
+	add(e *entry[K, V])
+	access(e *entry[K, V])
+	remove(e *entry[K, V])
+	victim(recency *list.List) *entry[K, V]
+	clear()
+}
+
+// lru evicts the least recently used element. The recency order is maintained by the cache itself.
+type lru[K comparable, V any] struct{}
+
+func (p *lru[K, V]) add(e *entry[K, V])    {}
+func (p *lru[K, V]) access(e *entry[K, V]) {}
+func (p *lru[K, V]) remove(e *entry[K, V]) {}
+func (p *lru[K, V]) clear()                {}
+
+func (p *lru[K, V]) victim(recency *list.List) *entry[K, V] {
+	if back := recency.Back(); back != nil {
+		return back.Value.(*entry[K, V])
+	}
+	return nil
+}
+
+// lfu evicts the least frequently used element using lists of elements grouped by usage frequency.
+type lfu[K comparable, V any] struct {
+	buckets map[int]*list.List
+	minFreq int
+}
+
+func (p *lfu[K, V]) add(e *entry[K, V]) {
+	e.freq = 1
+	p.minFreq = 1
+	p.push(e)
+}
+
+func (p *lfu[K, V]) access(e *entry[K, V]) {
+	p.remove(e)
+	e.freq++
+	p.push(e)
+}
+
+func (p *lfu[K, V]) remove(e *entry[K, V]) {
+	bucket := p.buckets[e.freq]
+	bucket.Remove(e.bucket)
+	e.bucket = nil
+	if bucket.Len() == 0 {
+		delete(p.buckets, e.freq)
+		if p.minFreq == e.freq {
+			p.minFreq++
+		}
+	}
+}
+
+func (p *lfu[K, V]) victim(*list.List) *entry[K, V] {
+	if len(p.buckets) == 0 {
+		return nil
+	}
+	bucket, ok := p.buckets[p.minFreq]
+	if !ok {
+		p.minFreq = 0
+		for freq := range p.buckets {
+			if p.minFreq == 0 || freq < p.minFreq {
+				p.minFreq = freq
+			}
+		}
+		bucket = p.buckets[p.minFreq]
+	}
+	return bucket.Back().Value.(*entry[K, V])
+}
+
+func (p *lfu[K, V]) clear() {
+	p.buckets = nil
+	p.minFreq = 0
+}
+
+func (p *lfu[K, V]) push(e *entry[K, V]) {
+	if p.buckets == nil {
+		p.buckets = map[int]*list.List{}
+	}
+	bucket, ok := p.buckets[e.freq]
+	if !ok {
+		bucket = list.New()
+		p.buckets[e.freq] = bucket
+	}
+	e.bucket = bucket.PushFront(e)
+}
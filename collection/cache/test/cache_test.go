@@ -0,0 +1,180 @@
+package test
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection/cache"
+	"github.com/m4gshm/gollections/seq2"
+)
+
+func Test_LRU(t *testing.T) {
+	lru := cache.NewLRU[int, string](2)
+	var evicted []int
+	lru.OnEvict(func(key int, _ string) { evicted = append(evicted, key) })
+
+	lru.Set(1, "1")
+	lru.Set(2, "2")
+	_, _ = lru.Get(1)
+	lru.Set(3, "3")
+
+	assert.Equal(t, []int{2}, evicted)
+	assert.False(t, lru.Contains(2))
+	assert.Equal(t, []int{3, 1}, seq2.Keys(lru.All).Slice())
+
+	v, ok := lru.Remove(1)
+	assert.True(t, ok)
+	assert.Equal(t, "1", v)
+	assert.Equal(t, []int{2}, evicted)
+	assert.Equal(t, 1, lru.Len())
+}
+
+func Test_LRU_Peek(t *testing.T) {
+	lru := cache.NewLRU[int, string](2)
+	lru.Set(1, "1")
+	lru.Set(2, "2")
+	_, _ = lru.Peek(1)
+	lru.Set(3, "3")
+
+	assert.False(t, lru.Contains(1))
+	assert.True(t, lru.Contains(2))
+}
+
+func Test_LFU(t *testing.T) {
+	lfu := cache.NewLFU[string, int](2)
+	var evicted []string
+	lfu.OnEvict(func(key string, _ int) { evicted = append(evicted, key) })
+
+	lfu.Set("a", 1)
+	lfu.Set("b", 2)
+	_, _ = lfu.Get("a")
+	_, _ = lfu.Get("a")
+	_, _ = lfu.Get("b")
+	lfu.Set("c", 3)
+	lfu.Set("d", 4)
+
+	assert.Equal(t, []string{"b", "c"}, evicted)
+	assert.Equal(t, []string{"d", "a"}, seq2.Keys(lfu.All).Slice())
+}
+
+func Test_TTL(t *testing.T) {
+	now := time.Unix(0, 0)
+	ttl := cache.NewTTLClock[int, string](0, time.Minute, func() time.Time { return now })
+	var evicted []int
+	ttl.OnEvict(func(key int, _ string) { evicted = append(evicted, key) })
+
+	ttl.Set(1, "1")
+	now = now.Add(30 * time.Second)
+	ttl.Set(2, "2")
+	now = now.Add(30 * time.Second)
+
+	_, ok := ttl.Get(1)
+	assert.False(t, ok)
+	v, ok := ttl.Get(2)
+	assert.True(t, ok)
+	assert.Equal(t, "2", v)
+	assert.Equal(t, []int{1}, evicted)
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, 0, ttl.Len())
+	assert.Equal(t, []int{1, 2}, evicted)
+}
+
+func Test_GetOrLoad_Singleflight(t *testing.T) {
+	lru := cache.NewLRU[int, int](10)
+	var calls atomic.Int32
+	release := make(chan struct{})
+	loader := func(key int) (int, error) {
+		calls.Add(1)
+		<-release
+		return key * 10, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]int, 5)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = lru.GetOrLoad(1, loader)
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, []int{10, 10, 10, 10, 10}, results)
+	v, ok := lru.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, 10, v)
+}
+
+func Test_GetOrLoad_SetWhileLoading(t *testing.T) {
+	lru := cache.NewLRU[int, int](10)
+	started, release := make(chan struct{}), make(chan struct{})
+	loaded := make(chan int)
+	go func() {
+		v, _ := lru.GetOrLoad(1, func(int) (int, error) {
+			close(started)
+			<-release
+			return 10, nil
+		})
+		loaded <- v
+	}()
+	<-started
+	lru.Set(1, 20)
+	close(release)
+
+	assert.Equal(t, 10, <-loaded)
+	v, ok := lru.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, 20, v)
+}
+
+func Test_GetOrLoad_Error(t *testing.T) {
+	lru := cache.NewLRU[int, int](10)
+	failure := errors.New("failure")
+
+	_, err := lru.GetOrLoad(1, func(int) (int, error) { return 0, failure })
+	assert.ErrorIs(t, err, failure)
+	assert.False(t, lru.Contains(1))
+
+	v, err := lru.GetOrLoad(1, func(int) (int, error) { return 1, nil })
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v)
+}
+
+func Test_Cache_Zero(t *testing.T) {
+	var unbounded cache.Cache[int, int]
+	unbounded.Set(1, 1)
+	unbounded.Set(2, 2)
+	assert.Equal(t, 2, unbounded.Len())
+	unbounded.Clear()
+	assert.True(t, unbounded.IsEmpty())
+}
+
+func Test_JSON(t *testing.T) {
+	lru := cache.NewLRU[string, int](2)
+	lru.Set("b", 2)
+	lru.Set("a", 1)
+	data, err := json.Marshal(lru)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1,"b":2}`, string(data))
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"x":1,"y":2,"z":3}`), lru))
+	assert.Equal(t, []string{"z", "y"}, seq2.Keys(lru.All).Slice())
+
+	var unbounded cache.Cache[int, string]
+	assert.NoError(t, json.Unmarshal([]byte(`{"1":"a"}`), &unbounded))
+	value, ok := unbounded.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, "a", value)
+	assert.Error(t, json.Unmarshal([]byte(`[1]`), &unbounded))
+}
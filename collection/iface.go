@@ -43,3 +43,13 @@ type Map[K comparable, V any] interface {
 
 	HasAny(func(K, V) bool) bool
 }
+
+// BiMapCollision defines how a bidirectional map resolves binding of a value that is already bound to another key.
+type BiMapCollision int
+
+const (
+	// ReplaceOnCollision unbinds the value from the previous key and binds it to the new one.
+	ReplaceOnCollision BiMapCollision = iota
+	// RejectOnCollision keeps the previous binding and rejects the new one.
+	RejectOnCollision
+)
@@ -3,11 +3,15 @@ package immutable
 
 import (
 	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection"
 	"github.com/m4gshm/gollections/collection/immutable/ordered"
+	"github.com/m4gshm/gollections/internal/bitset"
+	"github.com/m4gshm/gollections/internal/trie"
 	"github.com/m4gshm/gollections/map_"
 	"github.com/m4gshm/gollections/seq"
 	"github.com/m4gshm/gollections/seq2"
 	"github.com/m4gshm/gollections/slice"
+	"golang.org/x/exp/constraints"
 )
 
 // NewSet instantiates set and copies elements to it
@@ -65,3 +69,130 @@ func NewVector[T any](elements ...T) Vector[T] {
 func VectorFromSeq[T any](s seq.Seq[T]) Vector[T] {
 	return WrapVector(seq.Slice(s))
 }
+
+// NewMultiMap instantiates a multimap using key/value pairs
+func NewMultiMap[K, V comparable](elements ...c.KV[K, V]) MultiMap[K, V] {
+	return MultiMapFromSeq2(seq2.Of(elements...))
+}
+
+// NewMultiMapOrdered instantiates an ordered multimap using key/value pairs
+func NewMultiMapOrdered[K, V comparable](elements ...c.KV[K, V]) ordered.MultiMap[K, V] {
+	return ordered.NewMultiMap(elements...)
+}
+
+// MultiMapFromSeq2 creates a multimap with key/value pairs retrieved by the seq.
+func MultiMapFromSeq2[K, V comparable](seq seq.Seq2[K, V]) MultiMap[K, V] {
+	if seq == nil {
+		return MultiMap[K, V]{}
+	}
+	return WrapMultiMap(seq2.Group(seq))
+}
+
+// NewSetMultiMap instantiates a multimap of unique values using key/value pairs
+func NewSetMultiMap[K, V comparable](elements ...c.KV[K, V]) SetMultiMap[K, V] {
+	return SetMultiMapFromSeq2(seq2.Of(elements...))
+}
+
+// SetMultiMapFromSeq2 creates a multimap of unique values with key/value pairs retrieved by the seq.
+func SetMultiMapFromSeq2[K, V comparable](seq seq.Seq2[K, V]) SetMultiMap[K, V] {
+	uniques := map[K]map[V]struct{}{}
+	if seq != nil {
+		for key, val := range seq {
+			values := uniques[key]
+			if values == nil {
+				values = map[V]struct{}{}
+				uniques[key] = values
+			}
+			values[val] = struct{}{}
+		}
+	}
+	return WrapSetMultiMap(uniques)
+}
+
+// NewBiMap instantiates a bidirectional map using key/value pairs.
+// A value that is repeated with another key is rebound to that key.
+func NewBiMap[K, V comparable](elements ...c.KV[K, V]) BiMap[K, V] {
+	return BiMapFromSeq2(seq2.Of(elements...), collection.ReplaceOnCollision)
+}
+
+// BiMapFromSeq2 creates a bidirectional map with key/value pairs retrieved by the seq.
+// The 'collision' policy defines how a value repeated with another key is handled.
+func BiMapFromSeq2[K, V comparable](seq seq.Seq2[K, V], collision collection.BiMapCollision) BiMap[K, V] {
+	var (
+		forward  = map[K]V{}
+		backward = map[V]K{}
+	)
+	if seq != nil {
+		for key, value := range seq {
+			if oldKey, ok := backward[value]; ok {
+				if oldKey == key || collision == collection.RejectOnCollision {
+					continue
+				}
+				delete(forward, oldKey)
+			}
+			if oldValue, ok := forward[key]; ok {
+				delete(backward, oldValue)
+			}
+			forward[key] = value
+			backward[value] = key
+		}
+	}
+	return WrapBiMap(forward, backward)
+}
+
+// NewBag instantiates a bag and copies elements to it
+func NewBag[T comparable](elements ...T) Bag[T] {
+	return BagFromSeq(seq.Of(elements...))
+}
+
+// BagFromSeq creates a bag with elements retrieved by the seq.
+func BagFromSeq[T comparable](seq seq.Seq[T]) Bag[T] {
+	if seq == nil {
+		return Bag[T]{}
+	}
+	counts := map[T]int{}
+	for e := range seq {
+		counts[e]++
+	}
+	return WrapBag(counts)
+}
+
+// NewTrie instantiates a trie using key/value pairs
+func NewTrie[V any](elements ...c.KV[string, V]) Trie[V] {
+	return TrieFromSeq2(seq2.Of(elements...))
+}
+
+// TrieFromSeq2 creates a trie with key/value pairs retrieved by the seq.
+func TrieFromSeq2[V any](seq seq.Seq2[string, V]) Trie[V] {
+	if seq == nil {
+		return Trie[V]{}
+	}
+	root, size := &trie.Node[V]{}, 0
+	for key, value := range seq {
+		if trie.Set(root, key, value) {
+			size++
+		}
+	}
+	return Trie[V]{root: root, size: size}
+}
+
+// NewBitSet instantiates a bit set and copies elements to it
+func NewBitSet[T constraints.Integer](elements ...T) BitSet[T] {
+	return BitSetFromSeq(seq.Of(elements...))
+}
+
+// BitSetFromSeq creates a bit set with elements retrieved by the seq.
+// Negative elements and elements greater than math.MaxInt32 cause a panic.
+func BitSetFromSeq[T constraints.Integer](seq seq.Seq[T]) BitSet[T] {
+	var words []uint64
+	if seq != nil {
+		for e := range seq {
+			bit, err := bitset.Bit(e)
+			if err != nil {
+				panic(err)
+			}
+			words, _ = bitset.Set(words, bit)
+		}
+	}
+	return WrapBitSet[T](words)
+}
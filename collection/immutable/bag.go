@@ -0,0 +1,210 @@
+package immutable
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/map_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/slice"
+)
+
+// WrapBag creates a bag using a map of element counts as the internal storage.
+// The counts must be positive.
+func WrapBag[T comparable](elements map[T]int) Bag[T] {
+	size := 0
+	for _, count := range elements {
+		size += count
+	}
+	return Bag[T]{elements: elements, size: size}
+}
+
+// Bag is a multiset implementation that counts occurrences of equal elements. The elements must be comparable.
+type Bag[T comparable] struct {
+	elements map[T]int
+	size     int
+}
+
+var (
+	_ c.Checkable[int]    = Bag[int]{}
+	_ c.KVRange[int, int] = Bag[int]{}
+	_ fmt.Stringer        = (*Bag[int])(nil)
+	_ fmt.Stringer        = Bag[int]{}
+	_ json.Marshaler      = Bag[int]{}
+	_ json.Unmarshaler    = (*Bag[int])(nil)
+)
+
+// All is used to iterate through the distinct elements and their counts using `for e, count := range`.
+func (b Bag[T]) All(consumer func(T, int) bool) {
+	map_.TrackWhile(b.elements, consumer)
+}
+
+// Elements returns a seq that yields every element as many times as it occurs in the bag.
+func (b Bag[T]) Elements() seq.Seq[T] {
+	return func(yield func(T) bool) {
+		for e, count := range b.elements {
+			for range count {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Len returns the total amount of the element occurrences
+func (b Bag[T]) Len() int {
+	return b.size
+}
+
+// IsEmpty returns true if the bag is empty
+func (b Bag[T]) IsEmpty() bool {
+	return b.size == 0
+}
+
+// Count returns the amount of occurrences of an element
+func (b Bag[T]) Count(element T) int {
+	return b.elements[element]
+}
+
+// Contains checks if the bag contains an element
+func (b Bag[T]) Contains(element T) bool {
+	return b.Count(element) > 0
+}
+
+// Distinct returns the distinct elements of the bag
+func (b Bag[T]) Distinct() MapKeys[T, int] {
+	return WrapKeys(b.elements)
+}
+
+// MostCommon returns up to k elements with the highest counts in descending order of the counts, or nil if k is not positive.
+// Elements with equal counts are ordered by their values if they are numbers or strings, otherwise by their fmt representations.
+func (b Bag[T]) MostCommon(k int) []c.KV[T, int] {
+	if k <= 0 {
+		return nil
+	}
+	key := tieKey[T]()
+	ranked := make([]rank[T], 0, len(b.elements))
+	for e, count := range b.elements {
+		ranked = append(ranked, rank[T]{element: e, count: count, key: key(e)})
+	}
+	slice.Sort(ranked, func(a, b rank[T]) int {
+		return cmp.Or(cmp.Compare(b.count, a.count), a.key.compare(b.key))
+	})
+	return slice.Convert(slice.Top(k, ranked), func(r rank[T]) c.KV[T, int] { return c.KV[T, int]{K: r.element, V: r.count} })
+}
+
+// Sum returns a new bag that contains the occurrences of both bags
+func (b Bag[T]) Sum(other Bag[T]) Bag[T] {
+	out := map_.Clone(b.elements)
+	if out == nil {
+		out = map[T]int{}
+	}
+	for e, count := range other.elements {
+		out[e] += count
+	}
+	return WrapBag(out)
+}
+
+// Union returns a new bag where the count of every element is the maximum of the counts in both bags
+func (b Bag[T]) Union(other Bag[T]) Bag[T] {
+	out := map_.Clone(b.elements)
+	if out == nil {
+		out = map[T]int{}
+	}
+	for e, count := range other.elements {
+		out[e] = max(out[e], count)
+	}
+	return WrapBag(out)
+}
+
+// Intersection returns a new bag where the count of every element is the minimum of the counts in both bags
+func (b Bag[T]) Intersection(other Bag[T]) Bag[T] {
+	out := map[T]int{}
+	for e, count := range b.elements {
+		if count = min(count, other.Count(e)); count > 0 {
+			out[e] = count
+		}
+	}
+	return WrapBag(out)
+}
+
+// Difference returns a new bag where the counts of the 'other' bag are subtracted from the counts of this one
+func (b Bag[T]) Difference(other Bag[T]) Bag[T] {
+	out := map[T]int{}
+	for e, count := range b.elements {
+		if count -= other.Count(e); count > 0 {
+			out[e] = count
+		}
+	}
+	return WrapBag(out)
+}
+
+// Map collects the elements and their counts into a new map
+func (b Bag[T]) Map() map[T]int {
+	return map_.Clone(b.elements)
+}
+
+func (b Bag[T]) String() string {
+	return map_.ToString(b.elements)
+}
+
+// MarshalJSON encodes the bag as a JSON object of the element counts
+func (b Bag[T]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalSortedObject(b.All)
+}
+
+// UnmarshalJSON replaces the bag by the element counts of a JSON object. Non-positive counts are skipped.
+func (b *Bag[T]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	counts := map[T]int{}
+	if err := json_.UnmarshalObject(data, func(element T, count int) {
+		if count > 0 {
+			counts[element] += count
+		}
+	}); err != nil {
+		return err
+	}
+	*b = WrapBag(counts)
+	return nil
+}
+
+type rank[T any] struct {
+	element T
+	count   int
+	key     orderKey
+}
+
+// orderKey is the value of an element that breaks count ties, only one of the fields is used for every element type
+type orderKey struct {
+	i int64
+	u uint64
+	f float64
+	s string
+}
+
+func (k orderKey) compare(other orderKey) int {
+	return cmp.Or(cmp.Compare(k.i, other.i), cmp.Compare(k.u, other.u), cmp.Compare(k.f, other.f), strings.Compare(k.s, other.s))
+}
+
+// tieKey returns a builder of the element keys that break count ties
+func tieKey[T any]() func(T) orderKey {
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(e T) orderKey { return orderKey{i: reflect.ValueOf(e).Int()} }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(e T) orderKey { return orderKey{u: reflect.ValueOf(e).Uint()} }
+	case reflect.Float32, reflect.Float64:
+		return func(e T) orderKey { return orderKey{f: reflect.ValueOf(e).Float()} }
+	case reflect.String:
+		return func(e T) orderKey { return orderKey{s: reflect.ValueOf(e).String()} }
+	}
+	return func(e T) orderKey { return orderKey{s: fmt.Sprint(e)} }
+}
@@ -0,0 +1,210 @@
+package immutable
+
+import (
+	"encoding/json"
+	"fmt"
+
+	converte "github.com/m4gshm/gollections/break/kv/convert"
+	kvFiltere "github.com/m4gshm/gollections/break/kv/predicate"
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/kv/convert"
+	kvFilter "github.com/m4gshm/gollections/kv/predicate"
+	"github.com/m4gshm/gollections/map_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/seq2"
+)
+
+// WrapBiMap instantiates BiMap using the key to value and the value to key maps as internal storage.
+// The maps must be consistent with each other.
+func WrapBiMap[K, V comparable](forward map[K]V, backward map[V]K) BiMap[K, V] {
+	return BiMap[K, V]{forward: forward, backward: backward}
+}
+
+// BiMap is a bidirectional map implementation that guarantees the uniqueness of both keys and values.
+type BiMap[K, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+}
+
+var (
+	_ collection.Map[int, string]                          = (*BiMap[int, string])(nil)
+	_ collection.Map[int, string]                          = BiMap[int, string]{}
+	_ c.KeyVal[MapKeys[int, string], MapKeys[string, int]] = BiMap[int, string]{}
+	_ fmt.Stringer                                         = (*BiMap[int, string])(nil)
+	_ fmt.Stringer                                         = BiMap[int, string]{}
+	_ json.Marshaler                                       = BiMap[int, string]{}
+	_ json.Unmarshaler                                     = (*BiMap[int, string])(nil)
+)
+
+// Inverse returns the map with swapped keys and values.
+func (m BiMap[K, V]) Inverse() BiMap[V, K] {
+	return BiMap[V, K]{forward: m.backward, backward: m.forward}
+}
+
+// All is used to iterate through the collection using `for key, val := range`.
+func (m BiMap[K, V]) All(consumer func(K, V) bool) {
+	map_.TrackWhile(m.forward, consumer)
+}
+
+// Head returns the first key\value pair.
+func (m BiMap[K, V]) Head() (K, V, bool) {
+	return seq2.Head(m.All)
+}
+
+// Map collects the key/value pairs into a new map
+func (m BiMap[K, V]) Map() map[K]V {
+	return map_.Clone(m.forward)
+}
+
+// Len returns amount of elements
+func (m BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
+
+// IsEmpty returns true if the map is empty
+func (m BiMap[K, V]) IsEmpty() bool {
+	return collection.IsEmpty(m)
+}
+
+// Contains checks is the map contains a key
+func (m BiMap[K, V]) Contains(key K) (ok bool) {
+	if m.forward != nil {
+		_, ok = m.forward[key]
+	}
+	return ok
+}
+
+// ContainsValue checks is the map contains a value
+func (m BiMap[K, V]) ContainsValue(value V) (ok bool) {
+	if m.backward != nil {
+		_, ok = m.backward[value]
+	}
+	return ok
+}
+
+// Get returns the value for a key.
+// If ok==false, then the map does not contain the key.
+func (m BiMap[K, V]) Get(key K) (value V, ok bool) {
+	if m.forward != nil {
+		value, ok = m.forward[key]
+	}
+	return value, ok
+}
+
+// GetKey returns the key of a value.
+// If ok==false, then the map does not contain the value.
+func (m BiMap[K, V]) GetKey(value V) (key K, ok bool) {
+	if m.backward != nil {
+		key, ok = m.backward[value]
+	}
+	return key, ok
+}
+
+// Keys resutrns keys collection
+func (m BiMap[K, V]) Keys() MapKeys[K, V] {
+	return WrapKeys(m.forward)
+}
+
+// Values resutrns values collection
+func (m BiMap[K, V]) Values() MapKeys[V, K] {
+	return WrapKeys(m.backward)
+}
+
+func (m BiMap[K, V]) String() string {
+	return map_.ToString(m.forward)
+}
+
+// TrackEach applies the 'consumer' function for every key/value pairs
+func (m BiMap[K, V]) TrackEach(consumer func(K, V)) {
+	map_.TrackEach(m.forward, consumer)
+}
+
+// FilterKey returns a seq consisting of key/value pairs where the key satisfies the condition of the 'filter' function
+func (m BiMap[K, V]) FilterKey(filter func(K) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, kvFilter.Key[V](filter))
+}
+
+// FiltKey returns an errorable seq consisting of key/value pairs where the key satisfies the condition of the 'filter' function
+func (m BiMap[K, V]) FiltKey(filter func(K) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, kvFiltere.Key[V](filter))
+}
+
+// ConvertKey returns a seq that applies the 'converter' function to keys of the map
+func (m BiMap[K, V]) ConvertKey(converter func(K) K) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, convert.Key[V](converter))
+}
+
+// ConvKey returns an errorable seq that applies the 'converter' function to keys of the map
+func (m BiMap[K, V]) ConvKey(converter func(K) (K, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converte.Key[V](converter))
+}
+
+// FilterValue returns a seq consisting of key/value pairs where the value satisfies the condition of the 'filter' function
+func (m BiMap[K, V]) FilterValue(filter func(V) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, kvFilter.Value[K](filter))
+}
+
+// FiltValue returns an errorable seq consisting of key/value pairs where the value satisfies the condition of the 'filter' function
+func (m BiMap[K, V]) FiltValue(filter func(V) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, kvFiltere.Value[K](filter))
+}
+
+// ConvertValue returns a seq that applies the 'converter' function to values of the map
+func (m BiMap[K, V]) ConvertValue(converter func(V) V) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, convert.Value[K](converter))
+}
+
+// ConvValue returns an errorable seq that applies the 'converter' function to values of the map
+func (m BiMap[K, V]) ConvValue(converter func(V) (V, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converte.Value[K](converter))
+}
+
+// Filter returns a seq consisting of elements that satisfy the condition of the 'filter' function
+func (m BiMap[K, V]) Filter(filter func(K, V) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, filter)
+}
+
+// Filt returns an errorable seq consisting of elements that satisfy the condition of the 'filter' function
+func (m BiMap[K, V]) Filt(filter func(K, V) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, filter)
+}
+
+// Convert returns a seq that applies the 'converter' function to the collection elements
+func (m BiMap[K, V]) Convert(converter func(K, V) (K, V)) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, converter)
+}
+
+// Conv returns an errorable seq that applies the 'converter' function to the collection elements
+func (m BiMap[K, V]) Conv(converter func(K, V) (K, V, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converter)
+}
+
+// Reduce reduces the key/value pairs of the map into an one pair using the 'merge' function
+func (m BiMap[K, V]) Reduce(merge func(K, K, V, V) (K, V)) (K, V) {
+	return map_.Reduce(m.forward, merge)
+}
+
+// HasAny checks whether the map contains a key/value pair that satisfies the condition.
+func (m BiMap[K, V]) HasAny(condition func(K, V) bool) bool {
+	return map_.HasAny(m.forward, condition)
+}
+
+// MarshalJSON encodes the map as a JSON object
+func (m BiMap[K, V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalSortedObject(m.All)
+}
+
+// UnmarshalJSON replaces the map by the members of a JSON object
+func (m *BiMap[K, V]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	pairs, err := json_.UnmarshalPairs[K, V](data)
+	if err != nil {
+		return err
+	}
+	*m = NewBiMap(pairs...)
+	return nil
+}
@@ -0,0 +1,182 @@
+package immutable
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/internal/bitset"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/slice"
+	"golang.org/x/exp/constraints"
+)
+
+// WrapBitSet creates a bit set using a slice of 64-bit words as the internal storage.
+// The bit i of the word w represents the element w*64+i.
+func WrapBitSet[T constraints.Integer](words []uint64) BitSet[T] {
+	return BitSet[T]{words: bitset.Trim(words)}
+}
+
+// BitSet is a set implementation for dense non-negative integer elements that stores every element as a bit.
+// The elements must not be greater than math.MaxInt32.
+type BitSet[T constraints.Integer] struct {
+	words []uint64
+}
+
+var (
+	_ collection.Set[int] = (*BitSet[int])(nil)
+	_ collection.Set[int] = BitSet[int]{}
+	_ fmt.Stringer        = (*BitSet[int])(nil)
+	_ fmt.Stringer        = BitSet[int]{}
+	_ json.Marshaler      = BitSet[int]{}
+	_ json.Unmarshaler    = (*BitSet[int])(nil)
+)
+
+// All is used to iterate through the collection in ascending order using `for e := range`.
+func (s BitSet[T]) All(consumer func(T) bool) {
+	bitset.All(s.words, func(bit uint64) bool { return consumer(T(bit)) })
+}
+
+// Head returns the first element.
+func (s BitSet[T]) Head() (T, bool) {
+	return s.NextSetBit(0)
+}
+
+// NextSetBit returns the smallest element that is greater than or equal to the 'from' element
+func (s BitSet[T]) NextSetBit(from T) (T, bool) {
+	from = max(from, 0)
+	bit, ok := bitset.Next(s.words, uint64(from))
+	return T(bit), ok
+}
+
+// Slice collects the elements to a slice
+func (s BitSet[T]) Slice() []T {
+	return s.Append(make([]T, 0, s.Len()))
+}
+
+// Append collects the values to the specified 'out' slice
+func (s BitSet[T]) Append(out []T) []T {
+	for e := range s.All {
+		out = append(out, e)
+	}
+	return out
+}
+
+// Len returns amount of elements
+func (s BitSet[T]) Len() int {
+	return bitset.Count(s.words)
+}
+
+// PopCount returns amount of elements
+func (s BitSet[T]) PopCount() int {
+	return bitset.Count(s.words)
+}
+
+// IsEmpty returns true if the collection is empty
+func (s BitSet[T]) IsEmpty() bool {
+	return len(s.words) == 0
+}
+
+// Contains checks is the collection contains an element
+func (s BitSet[T]) Contains(element T) bool {
+	return element >= 0 && bitset.Contains(s.words, uint64(element))
+}
+
+// Union returns a new bit set that contains the elements of both sets
+func (s BitSet[T]) Union(other BitSet[T]) BitSet[T] {
+	return BitSet[T]{words: bitset.Union(s.words, other.words)}
+}
+
+// Intersection returns a new bit set that contains the elements present in both sets
+func (s BitSet[T]) Intersection(other BitSet[T]) BitSet[T] {
+	return BitSet[T]{words: bitset.Intersection(s.words, other.words)}
+}
+
+// Difference returns a new bit set that contains the elements of this set that are absent in the 'other' one
+func (s BitSet[T]) Difference(other BitSet[T]) BitSet[T] {
+	return BitSet[T]{words: bitset.Difference(s.words, other.words)}
+}
+
+// Xor returns a new bit set that contains the elements present in only one of the sets
+func (s BitSet[T]) Xor(other BitSet[T]) BitSet[T] {
+	return BitSet[T]{words: bitset.Xor(s.words, other.words)}
+}
+
+// ForEach applies the 'consumer' function for every element
+func (s BitSet[T]) ForEach(consumer func(T)) {
+	for e := range s.All {
+		consumer(e)
+	}
+}
+
+// Filter returns a seq consisting of elements that satisfy the condition of the 'filter' function
+func (s BitSet[T]) Filter(filter func(T) bool) seq.Seq[T] {
+	return collection.Filter(s, filter)
+}
+
+// Filt returns an errorable seq consisting of elements that satisfy the condition of the 'filter' function
+func (s BitSet[T]) Filt(filter func(T) (bool, error)) seq.SeqE[T] {
+	return collection.Filt(s, filter)
+}
+
+// Convert returns a seq that applies the 'converter' function to the collection elements
+func (s BitSet[T]) Convert(converter func(T) T) seq.Seq[T] {
+	return collection.Convert(s, converter)
+}
+
+// Conv returns an errorable seq that applies the 'converter' function to the collection elements
+func (s BitSet[T]) Conv(converter func(T) (T, error)) seq.SeqE[T] {
+	return collection.Conv(s, converter)
+}
+
+// Reduce reduces the elements into an one using the 'merge' function
+func (s BitSet[T]) Reduce(merge func(T, T) T) T {
+	return collection.Reduce(s, merge)
+}
+
+// HasAny checks whether the set contains an element that satisfies the condition.
+func (s BitSet[T]) HasAny(condition func(T) bool) bool {
+	_, ok := s.First(condition)
+	return ok
+}
+
+// First returns the first element that satisfies the condition.
+func (s BitSet[T]) First(condition func(T) bool) (T, bool) {
+	return collection.First(s, condition)
+}
+
+// Words returns a copy of the 64-bit words of the set
+func (s BitSet[T]) Words() []uint64 {
+	return slice.Clone(s.words)
+}
+
+func (s BitSet[T]) String() string {
+	return slice.ToString(s.Slice())
+}
+
+// MarshalJSON encodes the set as a JSON array
+func (s BitSet[T]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalArray(s.All)
+}
+
+// UnmarshalJSON replaces the set by the elements of a JSON array
+func (s *BitSet[T]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	elements, err := json_.UnmarshalArray[T](data)
+	if err != nil {
+		return err
+	}
+	var words []uint64
+	for _, e := range elements {
+		bit, err := bitset.Bit(e)
+		if err != nil {
+			return err
+		}
+		words, _ = bitset.Set(words, bit)
+	}
+	*s = WrapBitSet[T](words)
+	return nil
+}
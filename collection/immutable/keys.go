@@ -1,9 +1,11 @@
 package immutable
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/internal/json_"
 	"github.com/m4gshm/gollections/kv/predicate"
 	"github.com/m4gshm/gollections/map_"
 	"github.com/m4gshm/gollections/seq"
@@ -23,8 +25,10 @@ type MapKeys[K comparable, V any] struct {
 var (
 	_ collection.Collection[int] = (*MapKeys[int, any])(nil)
 	_ collection.Collection[int] = MapKeys[int, any]{}
+	_ collection.Set[int]        = MapKeys[int, any]{}
 	_ fmt.Stringer               = (*MapKeys[int, any])(nil)
 	_ fmt.Stringer               = MapKeys[int, any]{}
+	_ json.Marshaler             = MapKeys[int, any]{}
 )
 
 // All is used to iterate through the collection using `for key := range`.
@@ -47,6 +51,14 @@ func (m MapKeys[K, V]) IsEmpty() bool {
 	return collection.IsEmpty(m)
 }
 
+// Contains checks is the collection contains a key
+func (m MapKeys[K, V]) Contains(key K) (ok bool) {
+	if m.elements != nil {
+		_, ok = m.elements[key]
+	}
+	return ok
+}
+
 // Slice collects the elements to a slice
 func (m MapKeys[K, V]) Slice() []K {
 	return map_.Keys(m.elements)
@@ -104,3 +116,8 @@ func (m MapKeys[K, V]) First(condition func(K) bool) (K, bool) {
 func (m MapKeys[K, V]) String() string {
 	return slice.ToString(m.Slice())
 }
+
+// MarshalJSON encodes the keys as a JSON array
+func (m MapKeys[K, V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalArray(m.All)
+}
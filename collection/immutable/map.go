@@ -1,6 +1,7 @@
 package immutable
 
 import (
+	"encoding/json"
 	"fmt"
 
 	converte "github.com/m4gshm/gollections/break/kv/convert"
@@ -8,6 +9,7 @@ import (
 	"github.com/m4gshm/gollections/c"
 	"github.com/m4gshm/gollections/collection"
 	"github.com/m4gshm/gollections/collection/immutable/ordered"
+	"github.com/m4gshm/gollections/internal/json_"
 	"github.com/m4gshm/gollections/kv/convert"
 	kvFilter "github.com/m4gshm/gollections/kv/predicate"
 	"github.com/m4gshm/gollections/map_"
@@ -33,6 +35,8 @@ var (
 	_ c.KeyVal[MapKeys[int, any], MapValues[int, any]] = Map[int, any]{}
 	_ fmt.Stringer                                     = (*Map[int, any])(nil)
 	_ fmt.Stringer                                     = Map[int, any]{}
+	_ json.Marshaler                                   = Map[int, any]{}
+	_ json.Unmarshaler                                 = (*Map[int, any])(nil)
 )
 
 // All is used to iterate through the collection using `for key, val := range`.
@@ -183,3 +187,21 @@ func (m Map[K, V]) Reduce(merge func(K, K, V, V) (K, V)) (K, V) {
 func (m Map[K, V]) HasAny(condition func(K, V) bool) bool {
 	return map_.HasAny(m.elements, condition)
 }
+
+// MarshalJSON encodes the map as a JSON object
+func (m Map[K, V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalSortedObject(m.All)
+}
+
+// UnmarshalJSON replaces the map by the members of a JSON object
+func (m *Map[K, V]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	pairs, err := json_.UnmarshalPairs[K, V](data)
+	if err != nil {
+		return err
+	}
+	*m = NewMap(pairs...)
+	return nil
+}
@@ -0,0 +1,163 @@
+package immutable
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/internal/json_"
+	kv "github.com/m4gshm/gollections/kv/collection"
+	"github.com/m4gshm/gollections/map_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/seq2"
+	"github.com/m4gshm/gollections/slice"
+)
+
+// WrapMultiMap instantiates MultiMap using a map of value slices as internal storage.
+func WrapMultiMap[K, V comparable](elements map[K][]V) MultiMap[K, V] {
+	size := 0
+	for _, values := range elements {
+		size += len(values)
+	}
+	return MultiMap[K, V]{elements: elements, size: size}
+}
+
+// MultiMap is a collection implementation that associates several values with one key.
+type MultiMap[K, V comparable] struct {
+	elements map[K][]V
+	size     int
+}
+
+var (
+	_ kv.Collection[int, any, map[int][]any] = (*MultiMap[int, any])(nil)
+	_ kv.Collection[int, any, map[int][]any] = MultiMap[int, any]{}
+	_ c.Access[int, Vector[any]]             = MultiMap[int, any]{}
+	_ c.Checkable[int]                       = MultiMap[int, any]{}
+	_ fmt.Stringer                           = (*MultiMap[int, any])(nil)
+	_ fmt.Stringer                           = MultiMap[int, any]{}
+	_ json.Marshaler                         = MultiMap[int, any]{}
+	_ json.Unmarshaler                       = (*MultiMap[int, any])(nil)
+)
+
+// All is used to iterate through the key/value pairs using `for key, val := range`.
+// A key is repeated for each of its values.
+func (m MultiMap[K, V]) All(consumer func(K, V) bool) {
+	for key, values := range m.elements {
+		for _, value := range values {
+			if !consumer(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// Groups returns a seq of keys and their values.
+func (m MultiMap[K, V]) Groups() seq.Seq2[K, []V] {
+	return func(yield func(K, []V) bool) {
+		for key, values := range m.elements {
+			if !yield(key, slice.Clone(values)) {
+				return
+			}
+		}
+	}
+}
+
+// Head returns the first key\value pair.
+func (m MultiMap[K, V]) Head() (K, V, bool) {
+	return seq2.Head(m.All)
+}
+
+// Len returns the total amount of values
+func (m MultiMap[K, V]) Len() int {
+	return m.size
+}
+
+// KeysLen returns amount of keys
+func (m MultiMap[K, V]) KeysLen() int {
+	return len(m.elements)
+}
+
+// IsEmpty returns true if the map is empty
+func (m MultiMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Contains checks is the map contains a key
+func (m MultiMap[K, V]) Contains(key K) (ok bool) {
+	if m.elements != nil {
+		_, ok = m.elements[key]
+	}
+	return ok
+}
+
+// Get returns the values of a key.
+// If ok==false, then the map does not contain the key.
+func (m MultiMap[K, V]) Get(key K) (Vector[V], bool) {
+	values, ok := m.elements[key]
+	return WrapVector(values), ok
+}
+
+// Keys resutrns keys collection
+func (m MultiMap[K, V]) Keys() MapKeys[K, []V] {
+	return WrapKeys(m.elements)
+}
+
+// Map collects the keys and their values into a new map
+func (m MultiMap[K, V]) Map() map[K][]V {
+	return map_.DeepClone(m.elements, slice.Clone)
+}
+
+// TrackEach applies the 'consumer' function for every key/value pairs
+func (m MultiMap[K, V]) TrackEach(consumer func(K, V)) {
+	for key, values := range m.elements {
+		for _, value := range values {
+			consumer(key, value)
+		}
+	}
+}
+
+// Filter returns a seq consisting of key/value pairs that satisfy the condition of the 'filter' function
+func (m MultiMap[K, V]) Filter(filter func(K, V) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, filter)
+}
+
+// Reduce reduces the key/value pairs of the map into an one pair using the 'merge' function
+func (m MultiMap[K, V]) Reduce(merge func(K, K, V, V) (K, V)) (rk K, rv V) {
+	first := true
+	for key, value := range m.All {
+		if first {
+			rk, rv = key, value
+			first = false
+		} else {
+			rk, rv = merge(rk, key, rv, value)
+		}
+	}
+	return rk, rv
+}
+
+// HasAny checks whether the map contains a key\value pair that satisfies the condition.
+func (m MultiMap[K, V]) HasAny(condition func(K, V) bool) bool {
+	return seq2.HasAny(m.All, condition)
+}
+
+func (m MultiMap[K, V]) String() string {
+	return map_.ToString(m.elements)
+}
+
+// MarshalJSON encodes the multimap as a JSON object of value arrays
+func (m MultiMap[K, V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalSortedObject(m.Groups())
+}
+
+// UnmarshalJSON replaces the multimap by the members of a JSON object of value arrays
+func (m *MultiMap[K, V]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	pairs, err := json_.UnmarshalGroups[K, V](data)
+	if err != nil {
+		return err
+	}
+	*m = NewMultiMap(pairs...)
+	return nil
+}
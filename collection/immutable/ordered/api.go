@@ -3,7 +3,9 @@ package ordered
 
 import (
 	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/map_/resolv"
 	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/seq2"
 	"github.com/m4gshm/gollections/slice/clone"
 )
 
@@ -64,3 +66,17 @@ func MapFromSeq2[K comparable, V any](seq seq.Seq2[K, V]) Map[K, V] {
 	}
 	return WrapMap(order, uniques)
 }
+
+// NewMultiMap instantiates a multimap using key/value pairs
+func NewMultiMap[K, V comparable](elements ...c.KV[K, V]) MultiMap[K, V] {
+	return MultiMapFromSeq2(seq2.Of(elements...))
+}
+
+// MultiMapFromSeq2 creates a multimap with key/value pairs retrieved by the seq.
+func MultiMapFromSeq2[K, V comparable](seq seq.Seq2[K, V]) MultiMap[K, V] {
+	if seq == nil {
+		return MultiMap[K, V]{}
+	}
+	order, groups := seq2.MapResolvOrder(seq, resolv.Slice[K, V])
+	return WrapMultiMap(order, groups)
+}
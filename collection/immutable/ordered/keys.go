@@ -1,10 +1,12 @@
 package ordered
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/m4gshm/gollections/c"
 	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/internal/json_"
 	"github.com/m4gshm/gollections/seq"
 	"github.com/m4gshm/gollections/slice"
 )
@@ -23,8 +25,10 @@ var (
 	_ collection.Collection[int] = (*MapKeys[int])(nil)
 	_ collection.Collection[int] = MapKeys[int]{}
 	_ c.OrderedRange[int]        = MapKeys[int]{}
+	_ collection.Vector[int]     = MapKeys[int]{}
 	_ fmt.Stringer               = (*MapKeys[int])(nil)
 	_ fmt.Stringer               = MapKeys[int]{}
+	_ json.Marshaler             = MapKeys[int]{}
 )
 
 // All is used to iterate through the collection using `for key := range`.
@@ -68,6 +72,11 @@ func (m MapKeys[K]) Append(out []K) []K {
 	return out
 }
 
+// TrackEach applies the 'consumer' function for every index/element pair
+func (m MapKeys[K]) TrackEach(consumer func(int, K)) {
+	slice.TrackEach(m.keys, consumer)
+}
+
 // ForEach applies the 'consumer' function for every element
 func (m MapKeys[K]) ForEach(consumer func(K)) {
 	slice.ForEach(m.keys, consumer)
@@ -117,3 +126,8 @@ func (m MapKeys[K]) String() string {
 func (m MapKeys[K]) Get(index int) (K, bool) {
 	return slice.Gett(m.keys, index)
 }
+
+// MarshalJSON encodes the keys as a JSON array in the order of the map keys
+func (m MapKeys[K]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalArray(m.All)
+}
@@ -1,12 +1,14 @@
 package ordered
 
 import (
+	"encoding/json"
 	"fmt"
 
 	converte "github.com/m4gshm/gollections/break/kv/convert"
 	filtere "github.com/m4gshm/gollections/break/kv/predicate"
 	"github.com/m4gshm/gollections/c"
 	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/internal/json_"
 	"github.com/m4gshm/gollections/kv/convert"
 	kvfilter "github.com/m4gshm/gollections/kv/predicate"
 	"github.com/m4gshm/gollections/map_"
@@ -33,6 +35,8 @@ var (
 	_ c.KeyVal[MapKeys[int], MapValues[int, any]] = Map[int, any]{}
 	_ fmt.Stringer                                = (*Map[int, any])(nil)
 	_ fmt.Stringer                                = Map[int, any]{}
+	_ json.Marshaler                              = Map[int, any]{}
+	_ json.Unmarshaler                            = (*Map[int, any])(nil)
 )
 
 // All is used to iterate through the collection using `for key, val := range`.
@@ -187,3 +191,21 @@ func addToMap[K comparable, V any](key K, val V, order []K, uniques map[K]V) []K
 	}
 	return order
 }
+
+// MarshalJSON encodes the map as a JSON object in the order of the keys
+func (m Map[K, V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalObject(m.All)
+}
+
+// UnmarshalJSON replaces the map by the members of a JSON object keeping their order
+func (m *Map[K, V]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	pairs, err := json_.UnmarshalPairs[K, V](data)
+	if err != nil {
+		return err
+	}
+	*m = NewMap(pairs...)
+	return nil
+}
@@ -1,10 +1,12 @@
 package ordered
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/m4gshm/gollections/c"
 	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/internal/json_"
 	"github.com/m4gshm/gollections/seq"
 	"github.com/m4gshm/gollections/slice"
 )
@@ -26,6 +28,8 @@ var (
 	_ c.OrderedRange[int] = Set[int]{}
 	_ fmt.Stringer        = (*Set[int])(nil)
 	_ fmt.Stringer        = Set[int]{}
+	_ json.Marshaler      = Set[int]{}
+	_ json.Unmarshaler    = (*Set[int])(nil)
 )
 
 // All is used to iterate through the collection using `for e := range`.
@@ -116,6 +120,49 @@ func (s Set[T]) Contains(element T) (ok bool) {
 	return ok
 }
 
+// Union returns a new set that contains elements of both sets. Elements of the set go first, then elements of the 'other' one.
+func (s Set[T]) Union(other collection.Set[T]) Set[T] {
+	return SetFromSeq(seq.Union(s.All, other.All))
+}
+
+// Intersection returns a new set that contains elements of the set that are also contained in the 'other' one
+func (s Set[T]) Intersection(other collection.Set[T]) Set[T] {
+	return SetFromSeq(s.Filter(other.Contains))
+}
+
+// Difference returns a new set that contains elements of the set that are not contained in the 'other' one
+func (s Set[T]) Difference(other collection.Set[T]) Set[T] {
+	return SetFromSeq(s.Filter(func(e T) bool { return !other.Contains(e) }))
+}
+
+// SymmetricDifference returns a new set that contains elements that are contained in only one of the sets. Elements of the set go first, then elements of the 'other' one.
+func (s Set[T]) SymmetricDifference(other collection.Set[T]) Set[T] {
+	return SetFromSeq(seq.Union(
+		s.Filter(func(e T) bool { return !other.Contains(e) }),
+		seq.Filter(other.All, func(e T) bool { return !s.Contains(e) }),
+	))
+}
+
+// IsSubsetOf checks whether all elements of the set are contained in the 'other' one
+func (s Set[T]) IsSubsetOf(other collection.Set[T]) bool {
+	return s.Len() <= other.Len() && !s.HasAny(func(e T) bool { return !other.Contains(e) })
+}
+
+// IsSupersetOf checks whether the set contains all elements of the 'other' one
+func (s Set[T]) IsSupersetOf(other collection.Set[T]) bool {
+	return other.Len() <= s.Len() && !other.HasAny(func(e T) bool { return !s.Contains(e) })
+}
+
+// IsDisjoint checks whether the sets have no elements in common
+func (s Set[T]) IsDisjoint(other collection.Set[T]) bool {
+	return !s.HasAny(other.Contains)
+}
+
+// Equal checks whether the sets contain the same elements regardless of the order
+func (s Set[T]) Equal(other collection.Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubsetOf(other)
+}
+
 // Sort sorts the elements
 func (s Set[T]) Sort(comparer slice.Comparer[T]) Set[T] {
 	return s.sortBy(slice.Sort, comparer)
@@ -141,3 +188,21 @@ func addToSet[T comparable](e T, uniques map[T]struct{}, order []T) []T {
 	}
 	return order
 }
+
+// MarshalJSON encodes the set as a JSON array in the order of the elements
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalArray(s.All)
+}
+
+// UnmarshalJSON replaces the set by the elements of a JSON array keeping their order
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	elements, err := json_.UnmarshalArray[T](data)
+	if err != nil {
+		return err
+	}
+	*s = NewSet(elements...)
+	return nil
+}
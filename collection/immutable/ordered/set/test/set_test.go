@@ -119,3 +119,17 @@ type user struct {
 
 func (u *user) Name() string { return u.name }
 func (u *user) Age() int     { return u.age }
+
+func Test_Set_Algebra(t *testing.T) {
+	a, b := set.Of(3, 1, 2), set.Of(4, 2, 5)
+
+	assert.Equal(t, slice.Of(3, 1, 2, 4, 5), a.Union(b).Slice())
+	assert.Equal(t, slice.Of(2), a.Intersection(b).Slice())
+	assert.Equal(t, slice.Of(3, 1), a.Difference(b).Slice())
+	assert.Equal(t, slice.Of(3, 1, 4, 5), a.SymmetricDifference(b).Slice())
+
+	assert.True(t, set.Of(2, 3).IsSubsetOf(a))
+	assert.True(t, a.IsSupersetOf(set.Of(1)))
+	assert.False(t, a.IsDisjoint(b))
+	assert.True(t, a.Equal(set.Of(1, 2, 3)))
+}
@@ -1,10 +1,12 @@
 package ordered
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/m4gshm/gollections/c"
 	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/internal/json_"
 	"github.com/m4gshm/gollections/kv/predicate"
 	"github.com/m4gshm/gollections/map_"
 	"github.com/m4gshm/gollections/seq"
@@ -26,6 +28,7 @@ var (
 	_ collection.Collection[any] = (*MapValues[int, any])(nil)
 	_ c.OrderedRange[any]        = (*MapValues[int, any])(nil)
 	_ fmt.Stringer               = (*MapValues[int, any])(nil)
+	_ json.Marshaler             = MapValues[int, any]{}
 )
 
 // Head returns the first element.
@@ -126,3 +129,8 @@ func (m MapValues[K, V]) First(condition func(V) bool) (V, bool) {
 func (m MapValues[K, V]) String() string {
 	return slice.ToString(m.Slice())
 }
+
+// MarshalJSON encodes the values as a JSON array in the order of the map keys
+func (m MapValues[K, V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalArray(m.All)
+}
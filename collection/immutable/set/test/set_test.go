@@ -149,3 +149,18 @@ type user struct {
 
 func (u *user) Name() string { return u.name }
 func (u *user) Age() int     { return u.age }
+
+func Test_Set_Algebra(t *testing.T) {
+	a, b := set.Of(1, 2, 3), set.Of(3, 4)
+
+	assert.Equal(t, slice.Of(1, 2, 3, 4), sort.Asc(a.Union(b).Slice()))
+	assert.Equal(t, slice.Of(3), a.Intersection(b).Slice())
+	assert.Equal(t, slice.Of(1, 2), sort.Asc(a.Difference(b).Slice()))
+	assert.Equal(t, slice.Of(1, 2, 4), sort.Asc(a.SymmetricDifference(b).Slice()))
+
+	assert.True(t, set.Of(1, 2).IsSubsetOf(a))
+	assert.False(t, a.IsSupersetOf(b))
+	assert.True(t, a.IsDisjoint(set.Of(5)))
+	assert.True(t, a.Equal(oset.Of(3, 2, 1)))
+	assert.True(t, immutable.Set[int]{}.IsSubsetOf(a))
+}
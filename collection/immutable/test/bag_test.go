@@ -0,0 +1,34 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection/immutable"
+)
+
+func Test_Bag(t *testing.T) {
+	bag := immutable.NewBag("a", "b", "a")
+
+	assert.Equal(t, 3, bag.Len())
+	assert.Equal(t, 2, bag.Count("a"))
+	assert.Equal(t, 2, bag.Distinct().Len())
+}
+
+func Test_Bag_Algebra(t *testing.T) {
+	a := immutable.NewBag(1, 1, 1, 2)
+	b := immutable.NewBag(1, 2, 2, 3)
+
+	assert.Equal(t, map[int]int{1: 4, 2: 3, 3: 1}, a.Sum(b).Map())
+	assert.Equal(t, map[int]int{1: 3, 2: 2, 3: 1}, a.Union(b).Map())
+	assert.Equal(t, map[int]int{1: 1, 2: 1}, a.Intersection(b).Map())
+	assert.Equal(t, map[int]int{1: 2}, a.Difference(b).Map())
+}
+
+func Test_Bag_Zero(t *testing.T) {
+	var bag immutable.Bag[int]
+
+	assert.Equal(t, 0, bag.Len())
+	assert.Equal(t, map[int]int{1: 1}, bag.Union(immutable.NewBag(1)).Map())
+}
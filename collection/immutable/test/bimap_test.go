@@ -0,0 +1,28 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/k"
+	"github.com/m4gshm/gollections/seq2"
+)
+
+func Test_BiMap(t *testing.T) {
+	m := immutable.NewBiMap(k.V(1, "one"), k.V(2, "two"), k.V(3, "one"))
+
+	assert.Equal(t, map[int]string{2: "two", 3: "one"}, m.Map())
+	assert.Equal(t, map[string]int{"two": 2, "one": 3}, m.Inverse().Map())
+}
+
+func Test_BiMap_Reject(t *testing.T) {
+	m := immutable.BiMapFromSeq2(seq2.Of(k.V(1, "one"), k.V(2, "two"), k.V(3, "one")), collection.RejectOnCollision)
+
+	assert.Equal(t, map[int]string{1: "one", 2: "two"}, m.Map())
+	key, ok := m.GetKey("one")
+	assert.True(t, ok)
+	assert.Equal(t, 1, key)
+}
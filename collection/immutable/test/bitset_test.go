@@ -0,0 +1,29 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/slice"
+)
+
+func Test_BitSet(t *testing.T) {
+	set := immutable.NewBitSet[uint8](7, 0, 255, 7)
+
+	assert.Equal(t, 3, set.Len())
+	assert.Equal(t, slice.Of[uint8](0, 7, 255), set.Slice())
+	assert.True(t, set.Contains(255))
+	head, _ := set.Head()
+	assert.Equal(t, uint8(0), head)
+}
+
+func Test_BitSet_Algebra(t *testing.T) {
+	a := immutable.NewBitSet(1, 65, 129)
+	b := immutable.NewBitSet(65)
+
+	assert.Equal(t, slice.Of(1, 129), a.Xor(b).Slice())
+	assert.Equal(t, slice.Of(65), a.Intersection(b).Slice())
+	assert.True(t, b.Difference(a).IsEmpty())
+}
@@ -0,0 +1,77 @@
+package test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/collection/immutable/ordered"
+	"github.com/m4gshm/gollections/k"
+)
+
+func marshal(t *testing.T, value any) string {
+	data, err := json.Marshal(value)
+	assert.NoError(t, err)
+	return string(data)
+}
+
+type dto struct {
+	Vector immutable.Vector[int]
+	Set    immutable.Set[string]
+	Map    immutable.Map[int, string]
+	Order  ordered.Map[string, int]
+	Multi  immutable.MultiMap[string, int]
+}
+
+func Test_JSON_DTO(t *testing.T) {
+	in := dto{
+		Vector: immutable.NewVector(3, 1, 2),
+		Set:    immutable.NewSet("a"),
+		Map:    immutable.NewMap(k.V(2, "b"), k.V(1, "a")),
+		Order:  ordered.NewMap(k.V("z", 1), k.V("a", 2)),
+		Multi:  immutable.NewMultiMap(k.V("a", 1), k.V("a", 2)),
+	}
+	data := marshal(t, in)
+	assert.Equal(t, `{"Vector":[3,1,2],"Set":["a"],"Map":{"1":"a","2":"b"},"Order":{"z":1,"a":2},"Multi":{"a":[1,2]}}`, data)
+
+	var out dto
+	assert.NoError(t, json.Unmarshal([]byte(data), &out))
+	assert.Equal(t, []int{3, 1, 2}, out.Vector.Slice())
+	assert.True(t, out.Set.Contains("a"))
+	assert.Equal(t, map[int]string{1: "a", 2: "b"}, out.Map.Map())
+	assert.Equal(t, []string{"z", "a"}, out.Order.Keys().Slice())
+	assert.Equal(t, data, marshal(t, out))
+
+	empty := marshal(t, dto{})
+	assert.Equal(t, `{"Vector":[],"Set":[],"Map":{},"Order":{},"Multi":{}}`, empty)
+}
+
+func Test_JSON_Others(t *testing.T) {
+	assert.Equal(t, `{"a":2}`, marshal(t, immutable.NewBag("a", "a")))
+	assert.Equal(t, `[1,64]`, marshal(t, immutable.NewBitSet(64, 1)))
+	assert.Equal(t, `{"1":"a"}`, marshal(t, immutable.NewBiMap(k.V(1, "a"))))
+	assert.Equal(t, `{"a":[1]}`, marshal(t, immutable.NewSetMultiMap(k.V("a", 1), k.V("a", 1))))
+	assert.Equal(t, `{"b":1,"ba":2}`, marshal(t, immutable.NewTrie(k.V("ba", 2), k.V("b", 1))))
+	assert.Equal(t, `["x","y"]`, marshal(t, ordered.NewMap(k.V("x", 1), k.V("y", 2)).Keys()))
+	assert.Equal(t, `[1,2]`, marshal(t, ordered.NewMap(k.V("x", 1), k.V("y", 2)).Values()))
+	assert.Equal(t, `["b","a"]`, marshal(t, ordered.NewSet("b", "a")))
+
+	var bimap immutable.BiMap[int, string]
+	assert.NoError(t, json.Unmarshal([]byte(`{"1":"a","2":"a"}`), &bimap))
+	assert.Equal(t, map[int]string{2: "a"}, bimap.Map())
+
+	var multi ordered.MultiMap[string, int]
+	assert.NoError(t, json.Unmarshal([]byte(`{"b":[1],"a":[2,3]}`), &multi))
+	assert.Equal(t, `{"b":[1],"a":[2,3]}`, marshal(t, multi))
+
+	var trie immutable.Trie[int]
+	assert.NoError(t, json.Unmarshal([]byte(`{"k":1}`), &trie))
+	assert.True(t, trie.Contains("k"))
+
+	vector := immutable.NewVector(1)
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &vector))
+	assert.Equal(t, []int{1}, vector.Slice())
+	assert.Error(t, json.Unmarshal([]byte(`{"a":1}`), &vector))
+}
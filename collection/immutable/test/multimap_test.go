@@ -0,0 +1,64 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/k"
+	"github.com/m4gshm/gollections/slice"
+)
+
+func Test_MultiMap(t *testing.T) {
+	m := immutable.NewMultiMap(k.V("a", 1), k.V("b", 2), k.V("a", 3))
+
+	assert.Equal(t, 3, m.Len())
+	assert.Equal(t, 2, m.KeysLen())
+
+	a, ok := m.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, slice.Of(1, 3), a.Slice())
+	assert.Equal(t, map[string][]int{"a": {1, 3}, "b": {2}}, m.Map())
+}
+
+func Test_MultiMapOrdered(t *testing.T) {
+	m := immutable.NewMultiMapOrdered(k.V("b", 1), k.V("a", 2), k.V("b", 3))
+
+	keys := []string{}
+	groups := [][]int{}
+	for key, values := range m.Groups() {
+		keys = append(keys, key)
+		groups = append(groups, values)
+	}
+	assert.Equal(t, slice.Of("b", "a"), keys)
+	assert.Equal(t, [][]int{{1, 3}, {2}}, groups)
+
+	var b collection.Vector[int]
+	b, ok := m.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, slice.Of(1, 3), b.Slice())
+	assert.Equal(t, 2, b.Len())
+
+	_, ok = m.Get("c")
+	assert.False(t, ok)
+}
+
+func Test_SetMultiMap(t *testing.T) {
+	m := immutable.NewSetMultiMap(k.V("a", 1), k.V("a", 1), k.V("b", 2))
+
+	assert.Equal(t, 2, m.Len())
+	assert.True(t, m.ContainsValue("a", 1))
+	assert.False(t, m.ContainsValue("a", 2))
+}
+
+func Test_MultiMap_Zero(t *testing.T) {
+	var m immutable.MultiMap[int, string]
+
+	_, ok := m.Get(1)
+	assert.False(t, ok)
+	assert.Equal(t, 0, m.Len())
+	assert.True(t, m.IsEmpty())
+	assert.Equal(t, "[]", m.String())
+}
@@ -0,0 +1,34 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/k"
+	"github.com/m4gshm/gollections/seq2"
+	"github.com/m4gshm/gollections/slice"
+)
+
+func Test_Trie(t *testing.T) {
+	trie := immutable.NewTrie(k.V("tea", 1), k.V("ten", 2), k.V("to", 3), k.V("tea", 4))
+
+	assert.Equal(t, 3, trie.Len())
+	assert.Equal(t, slice.Of("tea", "ten", "to"), seq2.Keys(trie.All).Slice())
+	assert.Equal(t, slice.Of(4, 2), seq2.Values(trie.WithPrefix("te")).Slice())
+
+	key, v, ok := trie.LongestPrefixOf("tonight")
+	assert.True(t, ok)
+	assert.Equal(t, "to", key)
+	assert.Equal(t, 3, v)
+}
+
+func Test_Trie_Zero(t *testing.T) {
+	var trie immutable.Trie[int]
+
+	assert.True(t, trie.IsEmpty())
+	_, ok := trie.Get("")
+	assert.False(t, ok)
+	assert.Empty(t, seq2.Keys(trie.WithPrefix("")).Slice())
+}
@@ -1,9 +1,11 @@
 package immutable
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/internal/json_"
 	"github.com/m4gshm/gollections/kv/predicate"
 	"github.com/m4gshm/gollections/map_"
 	"github.com/m4gshm/gollections/seq"
@@ -25,6 +27,7 @@ var (
 	_ collection.Collection[any] = MapValues[int, any]{}
 	_ fmt.Stringer               = (*MapValues[int, any])(nil)
 	_ fmt.Stringer               = MapValues[int, any]{}
+	_ json.Marshaler             = MapValues[int, any]{}
 )
 
 // All is used to iterate through the collection using `for val := range`.
@@ -109,3 +112,8 @@ func (m MapValues[K, V]) Sort(comparer slice.Comparer[V]) Vector[V] {
 func (m MapValues[K, V]) String() string {
 	return slice.ToString(m.Slice())
 }
+
+// MarshalJSON encodes the values as a JSON array
+func (m MapValues[K, V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalArray(m.All)
+}
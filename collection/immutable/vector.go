@@ -1,12 +1,16 @@
 package immutable
 
 import (
+	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/m4gshm/gollections/c"
 	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/internal/json_"
 	"github.com/m4gshm/gollections/seq"
 	"github.com/m4gshm/gollections/slice"
+	"github.com/m4gshm/gollections/slice/sorted"
 )
 
 // WrapVector instantiates Vector using a slise as internal storage.
@@ -25,6 +29,8 @@ var (
 	_ c.OrderedRange[any]    = Vector[any]{}
 	_ fmt.Stringer           = (*Vector[any])(nil)
 	_ fmt.Stringer           = Vector[any]{}
+	_ json.Marshaler         = Vector[any]{}
+	_ json.Unmarshaler       = (*Vector[any])(nil)
 )
 
 // All is used to iterate through the collection using `for e := range`.
@@ -78,6 +84,38 @@ func (v Vector[T]) Get(index int) (out T, ok bool) {
 	return slice.Gett(v.elements, index)
 }
 
+// IndexOf returns the index of the first element that satisfies the condition, or -1 if none do
+func (v Vector[T]) IndexOf(condition func(T) bool) int {
+	return slices.IndexFunc(v.elements, condition)
+}
+
+// LastIndexOf returns the index of the last element that satisfies the condition, or -1 if none do
+func (v Vector[T]) LastIndexOf(condition func(T) bool) int {
+	for i := len(v.elements) - 1; i >= 0; i-- {
+		if condition(v.elements[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// SubVector returns a view of the elements in the range [from, to) that shares the storage with the vector.
+// Negative indexes count from the end of the vector. The range is clipped to the vector bounds.
+func (v Vector[T]) SubVector(from, to int) Vector[T] {
+	l := len(v.elements)
+	if from < 0 {
+		from += l
+	}
+	if to < 0 {
+		to += l
+	}
+	from, to = min(max(from, 0), l), min(max(to, 0), l)
+	if from >= to {
+		return Vector[T]{}
+	}
+	return WrapVector(v.elements[from:to:to])
+}
+
 // TrackEach applies the 'consumer' function for every key/value pairs
 func (v Vector[T]) TrackEach(consumer func(int, T)) {
 	slice.TrackEach(v.elements, consumer)
@@ -137,6 +175,65 @@ func (v Vector[T]) sortBy(sorter func([]T, slice.Comparer[T]) []T, comparer slic
 	return WrapVector(sorter(slice.Clone(v.elements), comparer))
 }
 
+// BinarySearch searches for the target in the vector sorted by the comparer and returns the position where the target is found,
+// or the position where the target would appear in the sort order, and a bool saying whether the target is really found.
+func (v Vector[T]) BinarySearch(target T, comparer slice.Comparer[T]) (int, bool) {
+	return sorted.BinarySearch(v.elements, target, comparer)
+}
+
+// LowerBound returns the index of the first element that is not less than the target in the vector sorted by the comparer
+func (v Vector[T]) LowerBound(target T, comparer slice.Comparer[T]) int {
+	return sorted.LowerBound(v.elements, target, comparer)
+}
+
+// UpperBound returns the index of the first element that is greater than the target in the vector sorted by the comparer
+func (v Vector[T]) UpperBound(target T, comparer slice.Comparer[T]) int {
+	return sorted.UpperBound(v.elements, target, comparer)
+}
+
+// EqualRange returns the range [from, to) of the elements that are equal to the target in the vector sorted by the comparer
+func (v Vector[T]) EqualRange(target T, comparer slice.Comparer[T]) (from, to int) {
+	return sorted.EqualRange(v.elements, target, comparer)
+}
+
+// Merge returns a new vector that combines elements of both vectors sorted by the comparer keeping all elements
+func (v Vector[T]) Merge(other Vector[T], comparer slice.Comparer[T]) Vector[T] {
+	return WrapVector(sorted.Merge(v.elements, other.elements, comparer))
+}
+
+// Union returns a new vector that contains elements of both vectors sorted by the comparer, equal elements are matched pairwise
+func (v Vector[T]) Union(other Vector[T], comparer slice.Comparer[T]) Vector[T] {
+	return WrapVector(sorted.Union(v.elements, other.elements, comparer))
+}
+
+// Intersect returns a new vector that contains elements of the vector matched pairwise with equal elements of the other one. Both vectors must be sorted by the comparer
+func (v Vector[T]) Intersect(other Vector[T], comparer slice.Comparer[T]) Vector[T] {
+	return WrapVector(sorted.Intersect(v.elements, other.elements, comparer))
+}
+
+// Difference returns a new vector that contains elements of the vector that are not matched pairwise with equal elements of the other one. Both vectors must be sorted by the comparer
+func (v Vector[T]) Difference(other Vector[T], comparer slice.Comparer[T]) Vector[T] {
+	return WrapVector(sorted.Difference(v.elements, other.elements, comparer))
+}
+
 func (v Vector[T]) String() string {
 	return slice.ToString(v.elements)
 }
+
+// MarshalJSON encodes the vector as a JSON array
+func (v Vector[T]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalArray(v.All)
+}
+
+// UnmarshalJSON replaces the vector by the elements of a JSON array
+func (v *Vector[T]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	elements, err := json_.UnmarshalArray[T](data)
+	if err != nil {
+		return err
+	}
+	*v = WrapVector(elements)
+	return nil
+}
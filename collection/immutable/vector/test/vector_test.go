@@ -96,3 +96,34 @@ type user struct {
 
 func (u *user) Name() string { return u.name }
 func (u *user) Age() int     { return u.age }
+
+func Test_Vector_IndexOfSubVector(t *testing.T) {
+	v := vector.Of(1, 2, 3, 2, 5)
+
+	assert.Equal(t, 1, v.IndexOf(func(e int) bool { return e == 2 }))
+	assert.Equal(t, 3, v.LastIndexOf(func(e int) bool { return e == 2 }))
+	assert.Equal(t, -1, v.IndexOf(func(e int) bool { return e == 4 }))
+
+	assert.Equal(t, slice.Of(2, 3), v.SubVector(1, 3).Slice())
+	assert.Equal(t, slice.Of(2, 5), v.SubVector(-2, v.Len()).Slice())
+	assert.Equal(t, slice.Of(1, 2, 3, 2), v.SubVector(-10, -1).Slice())
+	assert.True(t, v.SubVector(3, 1).IsEmpty())
+}
+
+func Test_Vector_Sorted(t *testing.T) {
+	compare := func(a, b int) int { return a - b }
+	v := vector.Of(1, 2, 2, 2, 5)
+
+	index, ok := v.BinarySearch(5, compare)
+	assert.True(t, ok)
+	assert.Equal(t, 4, index)
+	from, to := v.EqualRange(2, compare)
+	assert.Equal(t, 1, from)
+	assert.Equal(t, 4, to)
+
+	other := vector.Of(2, 3)
+	assert.Equal(t, slice.Of(1, 2, 2, 2, 3, 5), v.Union(other, compare).Slice())
+	assert.Equal(t, slice.Of(2), v.Intersect(other, compare).Slice())
+	assert.Equal(t, slice.Of(1, 2, 2, 5), v.Difference(other, compare).Slice())
+	assert.Equal(t, 7, v.Merge(other, compare).Len())
+}
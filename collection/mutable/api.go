@@ -2,12 +2,16 @@
 package mutable
 
 import (
+	"cmp"
+
 	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection"
 	"github.com/m4gshm/gollections/collection/mutable/ordered"
 	"github.com/m4gshm/gollections/map_"
 	"github.com/m4gshm/gollections/seq"
 	"github.com/m4gshm/gollections/seq2"
 	"github.com/m4gshm/gollections/slice"
+	"golang.org/x/exp/constraints"
 )
 
 // NewSet instantiates set and copies elements to it
@@ -83,3 +87,159 @@ func NewVectorCap[T any](capacity int) *Vector[T] {
 func VectorFromSeq[T any](s seq.Seq[T]) *Vector[T] {
 	return WrapVector(seq.Slice(s))
 }
+
+// NewMultiMap instantiates a multimap using key/value pairs
+func NewMultiMap[K, V comparable](elements ...c.KV[K, V]) *MultiMap[K, V] {
+	return MultiMapFromSeq2(seq2.Of(elements...))
+}
+
+// NewMultiMapOrdered instantiates an ordered multimap using key/value pairs
+func NewMultiMapOrdered[K, V comparable](elements ...c.KV[K, V]) *ordered.MultiMap[K, V] {
+	return ordered.NewMultiMap(elements...)
+}
+
+// MultiMapFromSeq2 creates a multimap with key/value pairs retrieved by the seq.
+func MultiMapFromSeq2[K, V comparable](seq seq.Seq2[K, V]) *MultiMap[K, V] {
+	if seq == nil {
+		return nil
+	}
+	return WrapMultiMap(seq2.Group(seq))
+}
+
+// NewSetMultiMap instantiates a multimap of unique values using key/value pairs
+func NewSetMultiMap[K, V comparable](elements ...c.KV[K, V]) *SetMultiMap[K, V] {
+	return SetMultiMapFromSeq2(seq2.Of(elements...))
+}
+
+// SetMultiMapFromSeq2 creates a multimap of unique values with key/value pairs retrieved by the seq.
+func SetMultiMapFromSeq2[K, V comparable](seq seq.Seq2[K, V]) *SetMultiMap[K, V] {
+	if seq == nil {
+		return nil
+	}
+	m := WrapSetMultiMap(map[K]map[V]struct{}{})
+	m.PutAll(seq)
+	return m
+}
+
+// NewBiMap instantiates a bidirectional map using key/value pairs.
+// A value that is set with another key is rebound to that key.
+func NewBiMap[K, V comparable](elements ...c.KV[K, V]) *BiMap[K, V] {
+	return NewBiMapCollision(collection.ReplaceOnCollision, elements...)
+}
+
+// NewBiMapCollision instantiates a bidirectional map with the 'collision' policy using key/value pairs.
+// The policy defines how a value that is set with another key is handled.
+func NewBiMapCollision[K, V comparable](collision collection.BiMapCollision, elements ...c.KV[K, V]) *BiMap[K, V] {
+	return BiMapFromSeq2(seq2.Of(elements...), collision)
+}
+
+// BiMapFromSeq2 creates a bidirectional map with the 'collision' policy and key/value pairs retrieved by the seq.
+func BiMapFromSeq2[K, V comparable](seq seq.Seq2[K, V], collision collection.BiMapCollision) *BiMap[K, V] {
+	if seq == nil {
+		return nil
+	}
+	m := WrapBiMap(map[K]V{}, map[V]K{}, collision)
+	for key, value := range seq {
+		m.Set(key, value)
+	}
+	return m
+}
+
+// NewBag instantiates a bag and copies elements to it
+func NewBag[T comparable](elements ...T) *Bag[T] {
+	return BagFromSeq(seq.Of(elements...))
+}
+
+// BagFromSeq creates a bag with elements retrieved by the seq.
+func BagFromSeq[T comparable](seq seq.Seq[T]) *Bag[T] {
+	if seq == nil {
+		return nil
+	}
+	counts := map[T]int{}
+	for e := range seq {
+		counts[e]++
+	}
+	return WrapBag(counts)
+}
+
+// NewTrie instantiates a trie using key/value pairs
+func NewTrie[V any](elements ...c.KV[string, V]) *Trie[V] {
+	return TrieFromSeq2(seq2.Of(elements...))
+}
+
+// TrieFromSeq2 creates a trie with key/value pairs retrieved by the seq.
+func TrieFromSeq2[V any](seq seq.Seq2[string, V]) *Trie[V] {
+	if seq == nil {
+		return nil
+	}
+	m := &Trie[V]{}
+	for key, value := range seq {
+		m.Set(key, value)
+	}
+	return m
+}
+
+// NewBitSet instantiates a bit set and copies elements to it
+func NewBitSet[T constraints.Integer](elements ...T) *BitSet[T] {
+	return BitSetFromSeq(seq.Of(elements...))
+}
+
+// BitSetFromSeq creates a bit set with elements retrieved by the seq.
+func BitSetFromSeq[T constraints.Integer](seq seq.Seq[T]) *BitSet[T] {
+	if seq == nil {
+		return nil
+	}
+	s := &BitSet[T]{}
+	s.AddAll(seq)
+	return s
+}
+
+// NewRangeMap instantiates an empty range map
+func NewRangeMap[K cmp.Ordered, V comparable]() *RangeMap[K, V] {
+	return &RangeMap[K, V]{}
+}
+
+// NewRangeSet instantiates a range set and adds the intervals to it
+func NewRangeSet[K cmp.Ordered](intervals ...Interval[K]) *RangeSet[K] {
+	s := &RangeSet[K]{}
+	for _, interval := range intervals {
+		s.Add(interval.From, interval.To)
+	}
+	return s
+}
+
+// NewHashMap instantiates a map that uses the 'hasher' functions to compare keys, and copies elements to it
+func NewHashMap[K, V any](hasher Hasher[K], elements ...c.KV[K, V]) *HashMap[K, V] {
+	return HashMapFromSeq2(hasher, seq2.Of(elements...))
+}
+
+// HashMapFromSeq2 creates a map that uses the 'hasher' functions to compare keys, with key/value pairs retrieved by the seq.
+// Panics with ErrNoHasher if the hasher functions are not defined.
+func HashMapFromSeq2[K, V any](hasher Hasher[K], seq seq.Seq2[K, V]) *HashMap[K, V] {
+	if !hasher.defined() {
+		panic(ErrNoHasher)
+	}
+	m := &HashMap[K, V]{hasher: hasher}
+	if seq != nil {
+		for key, value := range seq {
+			m.Set(key, value)
+		}
+	}
+	return m
+}
+
+// NewHashSet instantiates a set that uses the 'hasher' functions to compare elements, and copies elements to it
+func NewHashSet[T any](hasher Hasher[T], elements ...T) *HashSet[T] {
+	return HashSetFromSeq(hasher, seq.Of(elements...))
+}
+
+// HashSetFromSeq creates a set that uses the 'hasher' functions to compare elements, with elements retrieved by the seq.
+// Panics with ErrNoHasher if the hasher functions are not defined.
+func HashSetFromSeq[T any](hasher Hasher[T], seq seq.Seq[T]) *HashSet[T] {
+	if !hasher.defined() {
+		panic(ErrNoHasher)
+	}
+	s := &HashSet[T]{elements: HashMap[T, struct{}]{hasher: hasher}}
+	s.AddAll(seq)
+	return s
+}
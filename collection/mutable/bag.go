@@ -0,0 +1,249 @@
+package mutable
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/map_"
+	"github.com/m4gshm/gollections/seq"
+)
+
+// WrapBag creates a bag using a map of element counts as the internal storage.
+// The counts must be positive.
+func WrapBag[T comparable](elements map[T]int) *Bag[T] {
+	size := 0
+	for _, count := range elements {
+		size += count
+	}
+	return &Bag[T]{elements: elements, size: size}
+}
+
+// Bag is a multiset implementation that counts occurrences of equal elements. The elements must be comparable.
+type Bag[T comparable] struct {
+	elements map[T]int
+	size     int
+}
+
+var (
+	_ c.Addable[int]                            = (*Bag[int])(nil)
+	_ c.AddableAll[seq.Seq[int]]                = (*Bag[int])(nil)
+	_ c.Deleteable[int]                         = (*Bag[int])(nil)
+	_ c.Checkable[int]                          = (*Bag[int])(nil)
+	_ c.KVRange[int, int]                       = (*Bag[int])(nil)
+	_ c.ImmutableMapConvert[immutable.Bag[int]] = (*Bag[int])(nil)
+	_ fmt.Stringer                              = (*Bag[int])(nil)
+	_ json.Marshaler                            = (*Bag[int])(nil)
+	_ json.Unmarshaler                          = (*Bag[int])(nil)
+)
+
+// All is used to iterate through the distinct elements and their counts using `for e, count := range`.
+func (b *Bag[T]) All(consumer func(T, int) bool) {
+	if b != nil {
+		map_.TrackWhile(b.elements, consumer)
+	}
+}
+
+// Elements returns a seq that yields every element as many times as it occurs in the bag.
+func (b *Bag[T]) Elements() seq.Seq[T] {
+	return func(yield func(T) bool) {
+		for e, count := range b.All {
+			for range count {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Len returns the total amount of the element occurrences
+func (b *Bag[T]) Len() int {
+	if b == nil {
+		return 0
+	}
+	return b.size
+}
+
+// IsEmpty returns true if the bag is empty
+func (b *Bag[T]) IsEmpty() bool {
+	return b.Len() == 0
+}
+
+// Count returns the amount of occurrences of an element
+func (b *Bag[T]) Count(element T) int {
+	if b == nil {
+		return 0
+	}
+	return b.elements[element]
+}
+
+// Contains checks if the bag contains an element
+func (b *Bag[T]) Contains(element T) bool {
+	return b.Count(element) > 0
+}
+
+// Distinct returns the distinct elements of the bag
+func (b *Bag[T]) Distinct() immutable.MapKeys[T, int] {
+	var elements map[T]int
+	if b != nil {
+		elements = b.elements
+	}
+	return immutable.WrapKeys(elements)
+}
+
+// Add adds an occurrence of each element
+func (b *Bag[T]) Add(elements ...T) {
+	for _, element := range elements {
+		b.AddN(element, 1)
+	}
+}
+
+// AddOne adds an occurrence of the element
+func (b *Bag[T]) AddOne(element T) {
+	b.AddN(element, 1)
+}
+
+// AddN adds n occurrences of the element
+func (b *Bag[T]) AddN(element T, n int) {
+	if b == nil || n <= 0 {
+		return
+	} else if b.elements == nil {
+		b.elements = map[T]int{}
+	}
+	b.elements[element] += n
+	b.size += n
+}
+
+// AddAll adds an occurrence of each element retrieved by the seq
+func (b *Bag[T]) AddAll(elements seq.Seq[T]) {
+	if b != nil && elements != nil {
+		seq.ForEach(elements, b.AddOne)
+	}
+}
+
+// Remove removes up to n occurrences of the element and returns the amount of removed ones
+func (b *Bag[T]) Remove(element T, n int) int {
+	if b == nil || n <= 0 {
+		return 0
+	}
+	count, ok := b.elements[element]
+	if !ok {
+		return 0
+	}
+	if n >= count {
+		n = count
+		delete(b.elements, element)
+	} else {
+		b.elements[element] = count - n
+	}
+	b.size -= n
+	return n
+}
+
+// Delete removes all occurrences of the elements
+func (b *Bag[T]) Delete(elements ...T) {
+	for _, element := range elements {
+		b.DeleteOne(element)
+	}
+}
+
+// DeleteOne removes all occurrences of the element
+func (b *Bag[T]) DeleteOne(element T) {
+	if b != nil {
+		b.size -= b.elements[element]
+		delete(b.elements, element)
+	}
+}
+
+// MostCommon returns up to k elements with the highest counts in descending order of the counts, or nil if k is not positive.
+// Elements with equal counts are ordered like immutable.Bag.MostCommon does.
+func (b *Bag[T]) MostCommon(k int) []c.KV[T, int] {
+	var elements map[T]int
+	if b != nil {
+		elements = b.elements
+	}
+	return immutable.WrapBag(elements).MostCommon(k)
+}
+
+// Sum returns a new bag that contains the occurrences of both bags
+func (b *Bag[T]) Sum(other *Bag[T]) *Bag[T] {
+	out := b.Clone()
+	for e, count := range other.All {
+		out.AddN(e, count)
+	}
+	return out
+}
+
+// Union returns a new bag where the count of every element is the maximum of the counts in both bags
+func (b *Bag[T]) Union(other *Bag[T]) *Bag[T] {
+	out := b.Clone()
+	for e, count := range other.All {
+		out.AddN(e, count-out.Count(e))
+	}
+	return out
+}
+
+// Intersection returns a new bag where the count of every element is the minimum of the counts in both bags
+func (b *Bag[T]) Intersection(other *Bag[T]) *Bag[T] {
+	out := WrapBag(map[T]int{})
+	for e, count := range b.All {
+		out.AddN(e, min(count, other.Count(e)))
+	}
+	return out
+}
+
+// Difference returns a new bag where the counts of the 'other' bag are subtracted from the counts of this one
+func (b *Bag[T]) Difference(other *Bag[T]) *Bag[T] {
+	out := b.Clone()
+	for e, count := range other.All {
+		out.Remove(e, count)
+	}
+	return out
+}
+
+// Map collects the elements and their counts into a new map
+func (b *Bag[T]) Map() (out map[T]int) {
+	if b != nil {
+		out = map_.Clone(b.elements)
+	}
+	return out
+}
+
+// Clone returns copy of the bag
+func (b *Bag[T]) Clone() *Bag[T] {
+	return WrapBag(b.Map())
+}
+
+// Immutable converts to an immutable bag instance
+func (b *Bag[T]) Immutable() immutable.Bag[T] {
+	return immutable.WrapBag(b.Map())
+}
+
+func (b *Bag[T]) String() string {
+	var elements map[T]int
+	if b != nil {
+		elements = b.elements
+	}
+	return map_.ToString(elements)
+}
+
+// MarshalJSON encodes the bag as a JSON object of the element counts
+func (b *Bag[T]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalSortedObject(b.All)
+}
+
+// UnmarshalJSON replaces the bag content by the element counts of a JSON object. Non-positive counts are skipped.
+func (b *Bag[T]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	bag := WrapBag(map[T]int{})
+	if err := json_.UnmarshalObject(data, bag.AddN); err != nil {
+		return err
+	}
+	*b = *bag
+	return nil
+}
@@ -0,0 +1,341 @@
+package mutable
+
+import (
+	"encoding/json"
+	"fmt"
+
+	converte "github.com/m4gshm/gollections/break/kv/convert"
+	filtere "github.com/m4gshm/gollections/break/kv/predicate"
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/kv/convert"
+	kvfilter "github.com/m4gshm/gollections/kv/predicate"
+	"github.com/m4gshm/gollections/map_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/seq2"
+)
+
+// WrapBiMap instantiates BiMap using the key to value and the value to key maps as internal storage.
+// The maps must be consistent with each other.
+func WrapBiMap[K, V comparable](forward map[K]V, backward map[V]K, collision collection.BiMapCollision) *BiMap[K, V] {
+	return &BiMap[K, V]{forward: forward, backward: backward, collision: collision}
+}
+
+// BiMap is a bidirectional map implementation that guarantees the uniqueness of both keys and values.
+type BiMap[K, V comparable] struct {
+	forward   map[K]V
+	backward  map[V]K
+	collision collection.BiMapCollision
+}
+
+var (
+	_ c.Deleteable[int]                                                        = (*BiMap[int, string])(nil)
+	_ c.Removable[int, string]                                                 = (*BiMap[int, string])(nil)
+	_ c.Settable[int, string]                                                  = (*BiMap[int, string])(nil)
+	_ c.SettableNew[int, string]                                               = (*BiMap[int, string])(nil)
+	_ c.SettableMap[c.TrackEach[int, string]]                                  = (*BiMap[int, string])(nil)
+	_ c.ImmutableMapConvert[immutable.BiMap[int, string]]                      = (*BiMap[int, string])(nil)
+	_ collection.Map[int, string]                                              = (*BiMap[int, string])(nil)
+	_ c.KeyVal[immutable.MapKeys[int, string], immutable.MapKeys[string, int]] = (*BiMap[int, string])(nil)
+	_ fmt.Stringer                                                             = (*BiMap[int, string])(nil)
+	_ json.Marshaler                                                           = (*BiMap[int, string])(nil)
+	_ json.Unmarshaler                                                         = (*BiMap[int, string])(nil)
+)
+
+// Inverse returns a live view of the map with swapped keys and values.
+// Changes of the view are reflected in the map, and vice versa.
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	if m == nil {
+		return nil
+	}
+	m.init()
+	return &BiMap[V, K]{forward: m.backward, backward: m.forward, collision: m.collision}
+}
+
+// All is used to iterate through the collection using `for key, val := range`.
+func (m *BiMap[K, V]) All(consumer func(K, V) bool) {
+	if m != nil {
+		map_.TrackWhile(m.forward, consumer)
+	}
+}
+
+// Head returns the first key\value pair.
+func (m *BiMap[K, V]) Head() (K, V, bool) {
+	return seq2.Head(m.All)
+}
+
+// Map collects the key/value pairs into a new map
+func (m *BiMap[K, V]) Map() (out map[K]V) {
+	if m != nil {
+		out = map_.Clone(m.forward)
+	}
+	return out
+}
+
+// Len returns amount of elements
+func (m *BiMap[K, V]) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.forward)
+}
+
+// IsEmpty returns true if the map is empty
+func (m *BiMap[K, V]) IsEmpty() bool {
+	return collection.IsEmpty(m)
+}
+
+// TrackEach applies the 'consumer' function for every key/value pairs
+func (m *BiMap[K, V]) TrackEach(consumer func(K, V)) {
+	if m != nil {
+		map_.TrackEach(m.forward, consumer)
+	}
+}
+
+// Contains checks is the map contains a key
+func (m *BiMap[K, V]) Contains(key K) (ok bool) {
+	if m != nil {
+		_, ok = m.forward[key]
+	}
+	return ok
+}
+
+// ContainsValue checks is the map contains a value
+func (m *BiMap[K, V]) ContainsValue(value V) (ok bool) {
+	if m != nil {
+		_, ok = m.backward[value]
+	}
+	return ok
+}
+
+// Get returns the value for a key.
+// If ok==false, then the map does not contain the key.
+func (m *BiMap[K, V]) Get(key K) (value V, ok bool) {
+	if m != nil {
+		value, ok = m.forward[key]
+	}
+	return value, ok
+}
+
+// GetKey returns the key of a value.
+// If ok==false, then the map does not contain the value.
+func (m *BiMap[K, V]) GetKey(value V) (key K, ok bool) {
+	if m != nil {
+		key, ok = m.backward[value]
+	}
+	return key, ok
+}
+
+// Set sets the value for a key.
+// If the value is bound to another key, then the collision policy of the map is applied.
+func (m *BiMap[K, V]) Set(key K, value V) {
+	_ = m.Put(key, value)
+}
+
+// Put sets the value for a key.
+// If the value is bound to another key, then the collision policy of the map is applied.
+// Returns false if the binding was rejected.
+func (m *BiMap[K, V]) Put(key K, value V) bool {
+	if m == nil {
+		return false
+	}
+	m.init()
+	if oldKey, ok := m.backward[value]; ok {
+		if oldKey == key {
+			return true
+		} else if m.collision == collection.RejectOnCollision {
+			return false
+		}
+		delete(m.forward, oldKey)
+	}
+	if oldValue, ok := m.forward[key]; ok {
+		delete(m.backward, oldValue)
+	}
+	m.forward[key] = value
+	m.backward[value] = key
+	return true
+}
+
+// SetNew sets the value for a key only if neither the key nor the value exist in the map
+func (m *BiMap[K, V]) SetNew(key K, value V) bool {
+	if m == nil || m.Contains(key) || m.ContainsValue(value) {
+		return false
+	}
+	return m.Put(key, value)
+}
+
+// Delete removes value by their keys from the map
+func (m *BiMap[K, V]) Delete(keys ...K) {
+	for _, key := range keys {
+		m.DeleteOne(key)
+	}
+}
+
+// DeleteOne removes a value by the key from the map
+func (m *BiMap[K, V]) DeleteOne(key K) {
+	_, _ = m.Remove(key)
+}
+
+// Remove removes value by key and return it
+func (m *BiMap[K, V]) Remove(key K) (value V, ok bool) {
+	if m == nil {
+		return value, ok
+	}
+	if value, ok = m.forward[key]; ok {
+		delete(m.forward, key)
+		delete(m.backward, value)
+	}
+	return value, ok
+}
+
+// Keys resutrns keys collection
+func (m *BiMap[K, V]) Keys() immutable.MapKeys[K, V] {
+	var elements map[K]V
+	if m != nil {
+		elements = m.forward
+	}
+	return immutable.WrapKeys(elements)
+}
+
+// Values resutrns values collection
+func (m *BiMap[K, V]) Values() immutable.MapKeys[V, K] {
+	var elements map[V]K
+	if m != nil {
+		elements = m.backward
+	}
+	return immutable.WrapKeys(elements)
+}
+
+// String string representation on the map
+func (m *BiMap[K, V]) String() string {
+	var elements map[K]V
+	if m != nil {
+		elements = m.forward
+	}
+	return map_.ToString(elements)
+}
+
+// FilterKey returns a seq consisting of key/value pairs where the key satisfies the condition of the 'filter' function
+func (m *BiMap[K, V]) FilterKey(filter func(K) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, kvfilter.Key[V](filter))
+}
+
+// FiltKey returns an errorable seq consisting of key/value pairs where the key satisfies the condition of the 'filter' function
+func (m *BiMap[K, V]) FiltKey(filter func(K) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, filtere.Key[V](filter))
+}
+
+// ConvertKey returns a seq that applies the 'converter' function to keys of the map
+func (m *BiMap[K, V]) ConvertKey(converter func(K) K) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, convert.Key[V](converter))
+}
+
+// ConvKey returns an errorable seq that applies the 'converter' function to keys of the map
+func (m *BiMap[K, V]) ConvKey(converter func(K) (K, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converte.Key[V](converter))
+}
+
+// FilterValue returns a seq consisting of key/value pairs where the value satisfies the condition of the 'filter' function
+func (m *BiMap[K, V]) FilterValue(filter func(V) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, kvfilter.Value[K](filter))
+}
+
+// FiltValue returns an errorable seq consisting of key/value pairs where the value satisfies the condition of the 'filter' function
+func (m *BiMap[K, V]) FiltValue(filter func(V) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, filtere.Value[K](filter))
+}
+
+// ConvertValue returns a seq that applies the 'converter' function to values of the map
+func (m *BiMap[K, V]) ConvertValue(converter func(V) V) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, convert.Value[K](converter))
+}
+
+// ConvValue returns an errorable seq that applies the 'converter' function to values of the map
+func (m *BiMap[K, V]) ConvValue(converter func(V) (V, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converte.Value[K](converter))
+}
+
+// Filter returns a seq consisting of elements that satisfy the condition of the 'filter' function
+func (m *BiMap[K, V]) Filter(filter func(K, V) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, filter)
+}
+
+// Filt returns an errorable seq consisting of elements that satisfy the condition of the 'filter' function
+func (m *BiMap[K, V]) Filt(filter func(K, V) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, filter)
+}
+
+// Convert returns a seq that applies the 'converter' function to the collection elements
+func (m *BiMap[K, V]) Convert(converter func(K, V) (K, V)) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, converter)
+}
+
+// Conv returns an errorable seq that applies the 'converter' function to the collection elements
+func (m *BiMap[K, V]) Conv(converter func(K, V) (K, V, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converter)
+}
+
+// Reduce reduces the key/value pairs of the map into an one pair using the 'merge' function
+func (m *BiMap[K, V]) Reduce(merge func(K, K, V, V) (K, V)) (k K, v V) {
+	if m != nil {
+		k, v = map_.Reduce(m.forward, merge)
+	}
+	return k, v
+}
+
+// HasAny checks whether the map contains a key\value pair that satisfies the condition.
+func (m *BiMap[K, V]) HasAny(condition func(K, V) bool) bool {
+	if m != nil {
+		return map_.HasAny(m.forward, condition)
+	}
+	return false
+}
+
+// Immutable converts to an immutable map instance
+func (m *BiMap[K, V]) Immutable() immutable.BiMap[K, V] {
+	var (
+		forward  map[K]V
+		backward map[V]K
+	)
+	if m != nil {
+		forward, backward = map_.Clone(m.forward), map_.Clone(m.backward)
+	}
+	return immutable.WrapBiMap(forward, backward)
+}
+
+// SetMap inserts all elements from the 'other' map
+func (m *BiMap[K, V]) SetMap(other c.TrackEach[K, V]) {
+	if m == nil || other == nil {
+		return
+	}
+	other.TrackEach(func(key K, value V) { m.Set(key, value) })
+}
+
+func (m *BiMap[K, V]) init() {
+	if m.forward == nil {
+		m.forward = map[K]V{}
+	}
+	if m.backward == nil {
+		m.backward = map[V]K{}
+	}
+}
+
+// MarshalJSON encodes the map as a JSON object
+func (m *BiMap[K, V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalSortedObject(m.All)
+}
+
+// UnmarshalJSON replaces the map content by the members of a JSON object
+func (m *BiMap[K, V]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	pairs, err := json_.UnmarshalPairs[K, V](data)
+	if err != nil {
+		return err
+	}
+	*m = *BiMapFromSeq2(seq2.Of(pairs...), m.collision)
+	return nil
+}
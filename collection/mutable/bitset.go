@@ -0,0 +1,282 @@
+package mutable
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/internal/bitset"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/slice"
+	"golang.org/x/exp/constraints"
+)
+
+// WrapBitSet creates a bit set using a slice of 64-bit words as the internal storage.
+// The bit i of the word w represents the element w*64+i.
+func WrapBitSet[T constraints.Integer](words []uint64) *BitSet[T] {
+	return &BitSet[T]{words: bitset.Trim(words)}
+}
+
+// BitSet is a set implementation for dense non-negative integer elements that stores every element as a bit.
+// Adding a negative element or an element greater than math.MaxInt32 causes a panic.
+type BitSet[T constraints.Integer] struct {
+	words []uint64
+}
+
+var (
+	_ c.Addable[int]                               = (*BitSet[int])(nil)
+	_ c.AddableNew[int]                            = (*BitSet[int])(nil)
+	_ c.AddableAll[seq.Seq[int]]                   = (*BitSet[int])(nil)
+	_ c.AddableAllNew[seq.Seq[int]]                = (*BitSet[int])(nil)
+	_ c.Deleteable[int]                            = (*BitSet[int])(nil)
+	_ c.DeleteableVerify[int]                      = (*BitSet[int])(nil)
+	_ c.ImmutableMapConvert[immutable.BitSet[int]] = (*BitSet[int])(nil)
+	_ collection.Set[int]                          = (*BitSet[int])(nil)
+	_ fmt.Stringer                                 = (*BitSet[int])(nil)
+	_ json.Marshaler                               = (*BitSet[int])(nil)
+	_ json.Unmarshaler                             = (*BitSet[int])(nil)
+)
+
+// All is used to iterate through the collection in ascending order using `for e := range`.
+func (s *BitSet[T]) All(consumer func(T) bool) {
+	if s != nil {
+		bitset.All(s.words, func(bit uint64) bool { return consumer(T(bit)) })
+	}
+}
+
+// Head returns the first element.
+func (s *BitSet[T]) Head() (T, bool) {
+	return s.NextSetBit(0)
+}
+
+// NextSetBit returns the smallest element that is greater than or equal to the 'from' element
+func (s *BitSet[T]) NextSetBit(from T) (T, bool) {
+	return s.immutable().NextSetBit(from)
+}
+
+// Slice collects the elements to a slice
+func (s *BitSet[T]) Slice() []T {
+	return s.immutable().Slice()
+}
+
+// Append collects the values to the specified 'out' slice
+func (s *BitSet[T]) Append(out []T) []T {
+	return s.immutable().Append(out)
+}
+
+// Clone returns copy of the bit set
+func (s *BitSet[T]) Clone() *BitSet[T] {
+	if s == nil {
+		return nil
+	}
+	return &BitSet[T]{words: slice.Clone(s.words)}
+}
+
+// IsEmpty returns true if the collection is empty
+func (s *BitSet[T]) IsEmpty() bool {
+	return s == nil || len(s.words) == 0
+}
+
+// Len returns amount of elements
+func (s *BitSet[T]) Len() int {
+	return s.PopCount()
+}
+
+// PopCount returns amount of elements
+func (s *BitSet[T]) PopCount() int {
+	return bitset.Count(s.bits())
+}
+
+// Contains checks is the collection contains an element
+func (s *BitSet[T]) Contains(element T) bool {
+	return s != nil && element >= 0 && bitset.Contains(s.words, uint64(element))
+}
+
+// Add adds elements in the collection
+func (s *BitSet[T]) Add(elements ...T) {
+	for _, element := range elements {
+		s.AddOneNew(element)
+	}
+}
+
+// AddOne adds an element in the collection
+func (s *BitSet[T]) AddOne(element T) {
+	s.AddOneNew(element)
+}
+
+// AddNew inserts elements if they are not contained in the collection
+func (s *BitSet[T]) AddNew(elements ...T) (ok bool) {
+	for _, element := range elements {
+		ok = s.AddOneNew(element) || ok
+	}
+	return ok
+}
+
+// AddOneNew inserts an element if it is not contained in the collection
+func (s *BitSet[T]) AddOneNew(element T) (ok bool) {
+	if s == nil {
+		return false
+	}
+	bit, err := bitset.Bit(element)
+	if err != nil {
+		panic(err)
+	}
+	s.words, ok = bitset.Set(s.words, bit)
+	return ok
+}
+
+// AddAll inserts all elements from the "other" seq
+func (s *BitSet[T]) AddAll(other seq.Seq[T]) {
+	if s != nil && other != nil {
+		seq.ForEach(other, s.AddOne)
+	}
+}
+
+// AddAllNew inserts elements from the "other" seq if they are not contained in the collection
+func (s *BitSet[T]) AddAllNew(other seq.Seq[T]) (ok bool) {
+	if s != nil && other != nil {
+		seq.ForEach(other, func(element T) { ok = s.AddOneNew(element) || ok })
+	}
+	return ok
+}
+
+// Delete removes elements from the collection
+func (s *BitSet[T]) Delete(elements ...T) {
+	for _, element := range elements {
+		s.DeleteActualOne(element)
+	}
+}
+
+// DeleteOne removes an element from the collection
+func (s *BitSet[T]) DeleteOne(element T) {
+	s.DeleteActualOne(element)
+}
+
+// DeleteActual removes elements only if they are contained in the collection
+func (s *BitSet[T]) DeleteActual(elements ...T) (ok bool) {
+	for _, element := range elements {
+		ok = s.DeleteActualOne(element) || ok
+	}
+	return ok
+}
+
+// DeleteActualOne removes an element only if it is contained in the collection
+func (s *BitSet[T]) DeleteActualOne(element T) (ok bool) {
+	if s != nil && element >= 0 {
+		s.words, ok = bitset.Clear(s.words, uint64(element))
+	}
+	return ok
+}
+
+// Union returns a new bit set that contains the elements of both sets
+func (s *BitSet[T]) Union(other *BitSet[T]) *BitSet[T] {
+	return &BitSet[T]{words: bitset.Union(s.bits(), other.bits())}
+}
+
+// Intersection returns a new bit set that contains the elements present in both sets
+func (s *BitSet[T]) Intersection(other *BitSet[T]) *BitSet[T] {
+	return &BitSet[T]{words: bitset.Intersection(s.bits(), other.bits())}
+}
+
+// Difference returns a new bit set that contains the elements of this set that are absent in the 'other' one
+func (s *BitSet[T]) Difference(other *BitSet[T]) *BitSet[T] {
+	return &BitSet[T]{words: bitset.Difference(s.bits(), other.bits())}
+}
+
+// Xor returns a new bit set that contains the elements present in only one of the sets
+func (s *BitSet[T]) Xor(other *BitSet[T]) *BitSet[T] {
+	return &BitSet[T]{words: bitset.Xor(s.bits(), other.bits())}
+}
+
+// ForEach applies the 'consumer' function for every element
+func (s *BitSet[T]) ForEach(consumer func(T)) {
+	for e := range s.All {
+		consumer(e)
+	}
+}
+
+// Filter returns a seq consisting of elements that satisfy the condition of the 'filter' function
+func (s *BitSet[T]) Filter(filter func(T) bool) seq.Seq[T] {
+	return collection.Filter(s, filter)
+}
+
+// Filt returns an errorable seq consisting of elements that satisfy the condition of the 'filter' function
+func (s *BitSet[T]) Filt(filter func(T) (bool, error)) seq.SeqE[T] {
+	return collection.Filt(s, filter)
+}
+
+// Convert returns a seq that applies the 'converter' function to the collection elements
+func (s *BitSet[T]) Convert(converter func(T) T) seq.Seq[T] {
+	return collection.Convert(s, converter)
+}
+
+// Conv returns an errorable seq that applies the 'converter' function to the collection elements
+func (s *BitSet[T]) Conv(converter func(T) (T, error)) seq.SeqE[T] {
+	return collection.Conv(s, converter)
+}
+
+// Reduce reduces the elements into an one using the 'merge' function
+func (s *BitSet[T]) Reduce(merge func(T, T) T) T {
+	return collection.Reduce(s, merge)
+}
+
+// HasAny checks whether the set contains an element that satisfies the condition.
+func (s *BitSet[T]) HasAny(condition func(T) bool) bool {
+	_, ok := s.First(condition)
+	return ok
+}
+
+// First returns the first element that satisfies the condition.
+func (s *BitSet[T]) First(condition func(T) bool) (T, bool) {
+	return collection.First(s, condition)
+}
+
+// Immutable converts to an immutable bit set instance
+func (s *BitSet[T]) Immutable() immutable.BitSet[T] {
+	return immutable.WrapBitSet[T](slice.Clone(s.bits()))
+}
+
+func (s *BitSet[T]) String() string {
+	return s.immutable().String()
+}
+
+func (s *BitSet[T]) bits() []uint64 {
+	if s == nil {
+		return nil
+	}
+	return s.words
+}
+
+// immutable returns a read-only view of the bit set that shares the internal storage
+func (s *BitSet[T]) immutable() immutable.BitSet[T] {
+	return immutable.WrapBitSet[T](s.bits())
+}
+
+// MarshalJSON encodes the set as a JSON array
+func (s *BitSet[T]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalArray(s.All)
+}
+
+// UnmarshalJSON replaces the set elements by the elements of a JSON array
+func (s *BitSet[T]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	elements, err := json_.UnmarshalArray[T](data)
+	if err != nil {
+		return err
+	}
+	var words []uint64
+	for _, e := range elements {
+		bit, err := bitset.Bit(e)
+		if err != nil {
+			return err
+		}
+		words, _ = bitset.Set(words, bit)
+	}
+	*s = *WrapBitSet[T](words)
+	return nil
+}
@@ -0,0 +1,257 @@
+// Package cow provides copy-on-write collection implementations for read-mostly sharing
+package cow
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/collection/mutable"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/seq"
+)
+
+// NewMap instantiates a copy-on-write map using key/value pairs
+func NewMap[K comparable, V any](elements ...c.KV[K, V]) *Map[K, V] {
+	m := &Map[K, V]{}
+	m.store(immutable.NewMap(elements...))
+	return m
+}
+
+// Map is a copy-on-write map that holds an immutable snapshot.
+// The readers access the current snapshot without locking, the writers clone the snapshot under a mutex, modify the copy and swap it.
+type Map[K comparable, V any] struct {
+	mu       sync.Mutex
+	snapshot atomic.Pointer[immutable.Map[K, V]]
+}
+
+var (
+	_ c.Settable[int, any]                                                 = (*Map[int, any])(nil)
+	_ c.SettableNew[int, any]                                              = (*Map[int, any])(nil)
+	_ c.SettableMap[c.TrackEach[int, any]]                                 = (*Map[int, any])(nil)
+	_ c.Deleteable[int]                                                    = (*Map[int, any])(nil)
+	_ c.Removable[int, any]                                                = (*Map[int, any])(nil)
+	_ c.ImmutableMapConvert[immutable.Map[int, any]]                       = (*Map[int, any])(nil)
+	_ collection.Map[int, any]                                             = (*Map[int, any])(nil)
+	_ c.KeyVal[immutable.MapKeys[int, any], immutable.MapValues[int, any]] = (*Map[int, any])(nil)
+	_ fmt.Stringer                                                         = (*Map[int, any])(nil)
+	_ json.Marshaler                                                       = (*Map[int, any])(nil)
+	_ json.Unmarshaler                                                     = (*Map[int, any])(nil)
+)
+
+// Snapshot returns the current immutable state of the map
+func (m *Map[K, V]) Snapshot() immutable.Map[K, V] {
+	if snapshot := m.snapshot.Load(); snapshot != nil {
+		return *snapshot
+	}
+	return immutable.Map[K, V]{}
+}
+
+// Immutable returns the current immutable state of the map
+func (m *Map[K, V]) Immutable() immutable.Map[K, V] {
+	return m.Snapshot()
+}
+
+// Update applies the 'update' function to a copy of the map under the write lock and publishes the result as a new snapshot.
+// It is used for multi-step atomic modifications. The copy must not be retained after the function returns.
+func (m *Map[K, V]) Update(update func(*mutable.Map[K, V])) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	elements := mutable.WrapMap(m.Snapshot().Map())
+	update(elements)
+	m.store(immutable.WrapMap(map[K]V(*elements)))
+}
+
+// All is used to iterate through the current snapshot using `for key, val := range`.
+func (m *Map[K, V]) All(consumer func(K, V) bool) {
+	m.Snapshot().All(consumer)
+}
+
+// Head returns the first key\value pair.
+func (m *Map[K, V]) Head() (K, V, bool) {
+	return m.Snapshot().Head()
+}
+
+// Map collects the key/value pairs into a new map
+func (m *Map[K, V]) Map() map[K]V {
+	return m.Snapshot().Map()
+}
+
+// Len returns amount of elements
+func (m *Map[K, V]) Len() int {
+	return m.Snapshot().Len()
+}
+
+// IsEmpty returns true if the map is empty
+func (m *Map[K, V]) IsEmpty() bool {
+	return m.Snapshot().IsEmpty()
+}
+
+// Contains checks is the map contains a key
+func (m *Map[K, V]) Contains(key K) bool {
+	return m.Snapshot().Contains(key)
+}
+
+// Get returns the value for a key.
+// If ok==false, then the map does not contain the key.
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	return m.Snapshot().Get(key)
+}
+
+// Keys resutrns keys collection of the current snapshot
+func (m *Map[K, V]) Keys() immutable.MapKeys[K, V] {
+	return m.Snapshot().Keys()
+}
+
+// Values resutrns values collection of the current snapshot
+func (m *Map[K, V]) Values() immutable.MapValues[K, V] {
+	return m.Snapshot().Values()
+}
+
+// TrackEach applies the 'consumer' function for every key/value pairs of the current snapshot
+func (m *Map[K, V]) TrackEach(consumer func(K, V)) {
+	m.Snapshot().TrackEach(consumer)
+}
+
+// Set sets the value for a key
+func (m *Map[K, V]) Set(key K, value V) {
+	m.Update(func(elements *mutable.Map[K, V]) { elements.Set(key, value) })
+}
+
+// SetNew sets the value for a key only if the key is not exists in the map
+func (m *Map[K, V]) SetNew(key K, value V) (ok bool) {
+	if m.Contains(key) {
+		return false
+	}
+	m.Update(func(elements *mutable.Map[K, V]) { ok = elements.SetNew(key, value) })
+	return ok
+}
+
+// SetMap inserts all elements from the 'other' map
+func (m *Map[K, V]) SetMap(other c.TrackEach[K, V]) {
+	if other != nil {
+		m.Update(func(elements *mutable.Map[K, V]) { elements.SetMap(other) })
+	}
+}
+
+// Delete removes value by their keys from the map
+func (m *Map[K, V]) Delete(keys ...K) {
+	m.Update(func(elements *mutable.Map[K, V]) { elements.Delete(keys...) })
+}
+
+// DeleteOne removes a value by the key from the map
+func (m *Map[K, V]) DeleteOne(key K) {
+	_, _ = m.Remove(key)
+}
+
+// Remove removes value by key and return it
+func (m *Map[K, V]) Remove(key K) (value V, ok bool) {
+	if !m.Contains(key) {
+		return value, false
+	}
+	m.Update(func(elements *mutable.Map[K, V]) { value, ok = elements.Remove(key) })
+	return value, ok
+}
+
+// String string representation on the map
+func (m *Map[K, V]) String() string {
+	return m.Snapshot().String()
+}
+
+// FilterKey returns a seq consisting of key/value pairs where the key satisfies the condition of the 'filter' function
+func (m *Map[K, V]) FilterKey(filter func(K) bool) seq.Seq2[K, V] {
+	return m.Snapshot().FilterKey(filter)
+}
+
+// FiltKey returns an errorable seq consisting of key/value pairs where the key satisfies the condition of the 'filter' function
+func (m *Map[K, V]) FiltKey(filter func(K) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return m.Snapshot().FiltKey(filter)
+}
+
+// ConvertKey returns a seq that applies the 'converter' function to keys of the map
+func (m *Map[K, V]) ConvertKey(converter func(K) K) seq.Seq2[K, V] {
+	return m.Snapshot().ConvertKey(converter)
+}
+
+// ConvKey returns an errorable seq that applies the 'converter' function to keys of the map
+func (m *Map[K, V]) ConvKey(converter func(K) (K, error)) seq.SeqE[c.KV[K, V]] {
+	return m.Snapshot().ConvKey(converter)
+}
+
+// FilterValue returns a seq consisting of key/value pairs where the value satisfies the condition of the 'filter' function
+func (m *Map[K, V]) FilterValue(filter func(V) bool) seq.Seq2[K, V] {
+	return m.Snapshot().FilterValue(filter)
+}
+
+// FiltValue returns an errorable seq consisting of key/value pairs where the value satisfies the condition of the 'filter' function
+func (m *Map[K, V]) FiltValue(filter func(V) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return m.Snapshot().FiltValue(filter)
+}
+
+// ConvertValue returns a seq that applies the 'converter' function to values of the map
+func (m *Map[K, V]) ConvertValue(converter func(V) V) seq.Seq2[K, V] {
+	return m.Snapshot().ConvertValue(converter)
+}
+
+// ConvValue returns an errorable seq that applies the 'converter' function to values of the map
+func (m *Map[K, V]) ConvValue(converter func(V) (V, error)) seq.SeqE[c.KV[K, V]] {
+	return m.Snapshot().ConvValue(converter)
+}
+
+// Filter returns a seq consisting of elements that satisfy the condition of the 'filter' function
+func (m *Map[K, V]) Filter(filter func(K, V) bool) seq.Seq2[K, V] {
+	return m.Snapshot().Filter(filter)
+}
+
+// Filt returns an errorable seq consisting of elements that satisfy the condition of the 'filter' function
+func (m *Map[K, V]) Filt(filter func(K, V) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return m.Snapshot().Filt(filter)
+}
+
+// Convert returns a seq that applies the 'converter' function to the collection elements
+func (m *Map[K, V]) Convert(converter func(K, V) (K, V)) seq.Seq2[K, V] {
+	return m.Snapshot().Convert(converter)
+}
+
+// Conv returns an errorable seq that applies the 'converter' function to the collection elements
+func (m *Map[K, V]) Conv(converter func(K, V) (K, V, error)) seq.SeqE[c.KV[K, V]] {
+	return m.Snapshot().Conv(converter)
+}
+
+// Reduce reduces the key/value pairs of the map into an one pair using the 'merge' function
+func (m *Map[K, V]) Reduce(merge func(K, K, V, V) (K, V)) (K, V) {
+	return m.Snapshot().Reduce(merge)
+}
+
+// HasAny checks whether the map contains a key\value pair that satisfies the condition.
+func (m *Map[K, V]) HasAny(condition func(K, V) bool) bool {
+	return m.Snapshot().HasAny(condition)
+}
+
+func (m *Map[K, V]) store(snapshot immutable.Map[K, V]) {
+	m.snapshot.Store(&snapshot)
+}
+
+// MarshalJSON encodes the current snapshot of the map
+func (m *Map[K, V]) MarshalJSON() ([]byte, error) {
+	return m.Snapshot().MarshalJSON()
+}
+
+// UnmarshalJSON decodes a new snapshot of the map and publishes it
+func (m *Map[K, V]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	var snapshot immutable.Map[K, V]
+	if err := snapshot.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.store(snapshot)
+	return nil
+}
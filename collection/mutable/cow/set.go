@@ -0,0 +1,227 @@
+package cow
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/collection/immutable/ordered"
+	"github.com/m4gshm/gollections/collection/mutable"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/slice"
+)
+
+// NewSet instantiates a copy-on-write set and copies elements to it
+func NewSet[T comparable](elements ...T) *Set[T] {
+	s := &Set[T]{}
+	s.store(immutable.NewSet(elements...))
+	return s
+}
+
+// Set is a copy-on-write set that holds an immutable snapshot.
+// The readers access the current snapshot without locking, the writers clone the snapshot under a mutex, modify the copy and swap it.
+type Set[T comparable] struct {
+	mu       sync.Mutex
+	snapshot atomic.Pointer[immutable.Set[T]]
+}
+
+var (
+	_ c.Addable[int]                = (*Set[int])(nil)
+	_ c.AddableNew[int]             = (*Set[int])(nil)
+	_ c.AddableAll[seq.Seq[int]]    = (*Set[int])(nil)
+	_ c.AddableAllNew[seq.Seq[int]] = (*Set[int])(nil)
+	_ c.Deleteable[int]             = (*Set[int])(nil)
+	_ c.DeleteableVerify[int]       = (*Set[int])(nil)
+	_ collection.Set[int]           = (*Set[int])(nil)
+	_ fmt.Stringer                  = (*Set[int])(nil)
+	_ json.Marshaler                = (*Set[int])(nil)
+	_ json.Unmarshaler              = (*Set[int])(nil)
+)
+
+// Snapshot returns the current immutable state of the set
+func (s *Set[T]) Snapshot() immutable.Set[T] {
+	if snapshot := s.snapshot.Load(); snapshot != nil {
+		return *snapshot
+	}
+	return immutable.Set[T]{}
+}
+
+// Update applies the 'update' function to a copy of the set under the write lock and publishes the result as a new snapshot.
+// It is used for multi-step atomic modifications. The copy must not be retained after the function returns.
+func (s *Set[T]) Update(update func(*mutable.Set[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	elements := mutable.NewSet(s.Snapshot().Slice()...)
+	update(elements)
+	s.store(immutable.SetFromSeq(elements.All))
+}
+
+// All is used to iterate through the current snapshot using `for e := range`.
+func (s *Set[T]) All(consumer func(T) bool) {
+	s.Snapshot().All(consumer)
+}
+
+// Head returns the first element.
+func (s *Set[T]) Head() (T, bool) {
+	return s.Snapshot().Head()
+}
+
+// Slice collects the elements to a slice
+func (s *Set[T]) Slice() []T {
+	return s.Snapshot().Slice()
+}
+
+// Append collects the values to the specified 'out' slice
+func (s *Set[T]) Append(out []T) []T {
+	return s.Snapshot().Append(out)
+}
+
+// Len returns amount of elements
+func (s *Set[T]) Len() int {
+	return s.Snapshot().Len()
+}
+
+// IsEmpty returns true if the collection is empty
+func (s *Set[T]) IsEmpty() bool {
+	return s.Snapshot().IsEmpty()
+}
+
+// Contains checks if the collection contains an element
+func (s *Set[T]) Contains(element T) bool {
+	return s.Snapshot().Contains(element)
+}
+
+// Add adds elements in the collection
+func (s *Set[T]) Add(elements ...T) {
+	s.AddNew(elements...)
+}
+
+// AddOne adds an element in the collection
+func (s *Set[T]) AddOne(element T) {
+	s.AddOneNew(element)
+}
+
+// AddNew inserts elements if they are not contained in the collection
+func (s *Set[T]) AddNew(elements ...T) (ok bool) {
+	s.Update(func(set *mutable.Set[T]) { ok = set.AddNew(elements...) })
+	return ok
+}
+
+// AddOneNew inserts an element if it is not contained in the collection
+func (s *Set[T]) AddOneNew(element T) bool {
+	return !s.Contains(element) && s.AddNew(element)
+}
+
+// AddAll inserts all elements from the "other" seq
+func (s *Set[T]) AddAll(other seq.Seq[T]) {
+	s.AddAllNew(other)
+}
+
+// AddAllNew inserts elements from the "other" seq if they are not contained in the collection
+func (s *Set[T]) AddAllNew(other seq.Seq[T]) bool {
+	return other != nil && s.AddNew(other.Slice()...)
+}
+
+// Delete removes elements from the collection
+func (s *Set[T]) Delete(elements ...T) {
+	s.DeleteActual(elements...)
+}
+
+// DeleteOne removes an element from the collection
+func (s *Set[T]) DeleteOne(element T) {
+	s.DeleteActualOne(element)
+}
+
+// DeleteActual removes elements only if they are contained in the collection
+func (s *Set[T]) DeleteActual(elements ...T) (ok bool) {
+	s.Update(func(set *mutable.Set[T]) { ok = set.DeleteActual(elements...) })
+	return ok
+}
+
+// DeleteActualOne removes an element only if it is contained in the collection
+func (s *Set[T]) DeleteActualOne(element T) bool {
+	return s.Contains(element) && s.DeleteActual(element)
+}
+
+// ForEach applies the 'consumer' function for every element of the current snapshot
+func (s *Set[T]) ForEach(consumer func(T)) {
+	s.Snapshot().ForEach(consumer)
+}
+
+// Filter returns a seq consisting of elements that satisfy the condition of the 'filter' function
+func (s *Set[T]) Filter(filter func(T) bool) seq.Seq[T] {
+	return s.Snapshot().Filter(filter)
+}
+
+// Filt returns an errorable seq consisting of elements that satisfy the condition of the 'filter' function
+func (s *Set[T]) Filt(filter func(T) (bool, error)) seq.SeqE[T] {
+	return s.Snapshot().Filt(filter)
+}
+
+// Convert returns a seq that applies the 'converter' function to the collection elements
+func (s *Set[T]) Convert(converter func(T) T) seq.Seq[T] {
+	return s.Snapshot().Convert(converter)
+}
+
+// Conv returns an errorable seq that applies the 'converter' function to the collection elements
+func (s *Set[T]) Conv(converter func(T) (T, error)) seq.SeqE[T] {
+	return s.Snapshot().Conv(converter)
+}
+
+// Reduce reduces the elements into an one using the 'merge' function
+func (s *Set[T]) Reduce(merge func(T, T) T) T {
+	return s.Snapshot().Reduce(merge)
+}
+
+// HasAny checks whether the set contains an element that satisfies the condition.
+func (s *Set[T]) HasAny(condition func(T) bool) bool {
+	return s.Snapshot().HasAny(condition)
+}
+
+// First returns the element that satisfies the condition.
+func (s *Set[T]) First(condition func(T) bool) (T, bool) {
+	return s.Snapshot().First(condition)
+}
+
+// Sort transforms the current snapshot to the ordered Set contains sorted elements
+func (s *Set[T]) Sort(comparer slice.Comparer[T]) ordered.Set[T] {
+	return s.Snapshot().Sort(comparer)
+}
+
+// StableSort transforms the current snapshot to the ordered Set contains sorted elements
+func (s *Set[T]) StableSort(comparer slice.Comparer[T]) ordered.Set[T] {
+	return s.Snapshot().StableSort(comparer)
+}
+
+func (s *Set[T]) String() string {
+	return s.Snapshot().String()
+}
+
+func (s *Set[T]) store(snapshot immutable.Set[T]) {
+	s.snapshot.Store(&snapshot)
+}
+
+// MarshalJSON encodes the current snapshot of the set
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	return s.Snapshot().MarshalJSON()
+}
+
+// UnmarshalJSON decodes a new snapshot of the set and publishes it
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	var snapshot immutable.Set[T]
+	if err := snapshot.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.store(snapshot)
+	return nil
+}
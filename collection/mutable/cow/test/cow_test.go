@@ -0,0 +1,174 @@
+package test
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection/mutable"
+	"github.com/m4gshm/gollections/collection/mutable/cow"
+	"github.com/m4gshm/gollections/k"
+	"github.com/m4gshm/gollections/seq2"
+	"github.com/m4gshm/gollections/slice"
+)
+
+func Test_Map_Snapshot(t *testing.T) {
+	m := cow.NewMap(k.V(1, "1"), k.V(2, "2"))
+	snapshot := m.Snapshot()
+
+	m.Set(3, "3")
+	m.Delete(1)
+
+	assert.Equal(t, 2, snapshot.Len())
+	assert.True(t, snapshot.Contains(1))
+	assert.False(t, snapshot.Contains(3))
+
+	keys := seq2.Keys(m.All).Slice()
+	sort.Ints(keys)
+	assert.Equal(t, slice.Of(2, 3), keys)
+}
+
+func Test_Map_SetNewRemove(t *testing.T) {
+	m := cow.NewMap[int, string]()
+	assert.True(t, m.SetNew(1, "1"))
+	assert.False(t, m.SetNew(1, "one"))
+
+	v, ok := m.Remove(1)
+	assert.True(t, ok)
+	assert.Equal(t, "1", v)
+	_, ok = m.Remove(1)
+	assert.False(t, ok)
+}
+
+func Test_Map_ZeroValue(t *testing.T) {
+	var m cow.Map[int, string]
+	assert.True(t, m.IsEmpty())
+	_, ok := m.Get(1)
+	assert.False(t, ok)
+
+	m.Set(1, "1")
+	v, _ := m.Get(1)
+	assert.Equal(t, "1", v)
+}
+
+func Test_Map_Concurrent(t *testing.T) {
+	m := cow.NewMap[int, int]()
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			m.Update(func(elements *mutable.Map[int, int]) {
+				v, _ := elements.Get(0)
+				elements.Set(0, v+1)
+			})
+			m.Set(i+1, i)
+		}()
+		go func() {
+			defer wg.Done()
+			for range m.All {
+			}
+			_ = m.Snapshot().Len()
+		}()
+	}
+	wg.Wait()
+
+	v, _ := m.Get(0)
+	assert.Equal(t, 50, v)
+	assert.Equal(t, 51, m.Len())
+}
+
+func Test_Set_Snapshot(t *testing.T) {
+	s := cow.NewSet(1, 2, 3)
+	snapshot := s.Snapshot()
+
+	assert.True(t, s.AddNew(4))
+	assert.False(t, s.AddOneNew(4))
+	assert.True(t, s.DeleteActualOne(1))
+	assert.False(t, s.DeleteActual(1))
+
+	assert.Equal(t, slice.Of(1, 2, 3), snapshot.Sort(func(a, b int) int { return a - b }).Slice())
+	assert.Equal(t, slice.Of(2, 3, 4), s.Sort(func(a, b int) int { return a - b }).Slice())
+}
+
+func Test_Set_Concurrent(t *testing.T) {
+	var s cow.Set[int]
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			s.Add(i % 10)
+		}()
+		go func() {
+			defer wg.Done()
+			_ = s.Contains(i % 10)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 10, s.Len())
+}
+
+func Test_Vector_Snapshot(t *testing.T) {
+	v := cow.NewVector(3, 1, 2)
+	snapshot := v.Snapshot()
+
+	v.Add(0)
+	v.Set(0, 10)
+	e, ok := v.Remove(1)
+	assert.True(t, ok)
+	assert.Equal(t, 1, e)
+
+	assert.Equal(t, slice.Of(3, 1, 2), snapshot.Slice())
+	assert.Equal(t, slice.Of(10, 2, 0), v.Slice())
+
+	v.Sort(func(a, b int) int { return a - b })
+	assert.Equal(t, slice.Of(0, 2, 10), v.Slice())
+	assert.Equal(t, slice.Of(3, 1, 2), snapshot.Slice())
+}
+
+func Test_Vector_Concurrent(t *testing.T) {
+	var v cow.Vector[int]
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			v.Add(i)
+		}()
+		go func() {
+			defer wg.Done()
+			for range v.All {
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 50, v.Len())
+}
+
+func Test_JSON(t *testing.T) {
+	m := cow.NewMap(k.V("b", 2), k.V("a", 1))
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1,"b":2}`, string(data))
+
+	snapshot := m.Snapshot()
+	assert.NoError(t, json.Unmarshal([]byte(`{"c":3}`), m))
+	assert.Equal(t, map[string]int{"c": 3}, m.Map())
+	assert.Equal(t, 2, snapshot.Len())
+
+	var v cow.Vector[int]
+	assert.NoError(t, json.Unmarshal([]byte(`[1,2]`), &v))
+	data, err = json.Marshal(&v)
+	assert.NoError(t, err)
+	assert.Equal(t, `[1,2]`, string(data))
+
+	var s cow.Set[int]
+	assert.NoError(t, json.Unmarshal([]byte(`[1,1]`), &s))
+	assert.Equal(t, 1, s.Len())
+}
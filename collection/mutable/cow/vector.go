@@ -0,0 +1,249 @@
+package cow
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/collection/mutable"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/slice"
+)
+
+// NewVector instantiates a copy-on-write vector and copies elements to it
+func NewVector[T any](elements ...T) *Vector[T] {
+	v := &Vector[T]{}
+	v.store(immutable.NewVector(elements...))
+	return v
+}
+
+// Vector is a copy-on-write vector that holds an immutable snapshot.
+// The readers access the current snapshot without locking, the writers clone the snapshot under a mutex, modify the copy and swap it.
+type Vector[T any] struct {
+	mu       sync.Mutex
+	snapshot atomic.Pointer[immutable.Vector[T]]
+}
+
+var (
+	_ c.Addable[any]             = (*Vector[any])(nil)
+	_ c.AddableAll[seq.Seq[any]] = (*Vector[any])(nil)
+	_ c.Deleteable[int]          = (*Vector[any])(nil)
+	_ c.DeleteableVerify[int]    = (*Vector[any])(nil)
+	_ c.Settable[int, any]       = (*Vector[any])(nil)
+	_ c.SettableNew[int, any]    = (*Vector[any])(nil)
+	_ c.OrderedRange[any]        = (*Vector[any])(nil)
+	_ collection.Vector[any]     = (*Vector[any])(nil)
+	_ fmt.Stringer               = (*Vector[any])(nil)
+	_ json.Marshaler             = (*Vector[any])(nil)
+	_ json.Unmarshaler           = (*Vector[any])(nil)
+)
+
+// Snapshot returns the current immutable state of the vector
+func (v *Vector[T]) Snapshot() immutable.Vector[T] {
+	if snapshot := v.snapshot.Load(); snapshot != nil {
+		return *snapshot
+	}
+	return immutable.Vector[T]{}
+}
+
+// Update applies the 'update' function to a copy of the vector under the write lock and publishes the result as a new snapshot.
+// It is used for multi-step atomic modifications. The copy must not be retained after the function returns.
+func (v *Vector[T]) Update(update func(*mutable.Vector[T])) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	elements := mutable.WrapVector(v.Snapshot().Slice())
+	update(elements)
+	v.store(immutable.WrapVector([]T(*elements)))
+}
+
+// All is used to iterate through the current snapshot using `for e := range`.
+func (v *Vector[T]) All(consumer func(T) bool) {
+	v.Snapshot().All(consumer)
+}
+
+// IAll is used to iterate through the current snapshot using `for i, e := range`.
+func (v *Vector[T]) IAll(consumer func(int, T) bool) {
+	v.Snapshot().IAll(consumer)
+}
+
+// Head returns the first element
+func (v *Vector[T]) Head() (T, bool) {
+	return v.Snapshot().Head()
+}
+
+// Tail returns the latest element
+func (v *Vector[T]) Tail() (T, bool) {
+	return v.Snapshot().Tail()
+}
+
+// Slice collects the elements to a slice
+func (v *Vector[T]) Slice() []T {
+	return v.Snapshot().Slice()
+}
+
+// Append collects the values to the specified 'out' slice
+func (v *Vector[T]) Append(out []T) []T {
+	return v.Snapshot().Append(out)
+}
+
+// Len returns amount of elements
+func (v *Vector[T]) Len() int {
+	return v.Snapshot().Len()
+}
+
+// IsEmpty returns true if the collection is empty
+func (v *Vector[T]) IsEmpty() bool {
+	return v.Snapshot().IsEmpty()
+}
+
+// Get returns an element by the index, otherwise, if the provided index is ouf of the vector len, returns zero T and false in the second result
+func (v *Vector[T]) Get(index int) (T, bool) {
+	return v.Snapshot().Get(index)
+}
+
+// TrackEach applies the 'consumer' function to every element of the current snapshot
+func (v *Vector[T]) TrackEach(consumer func(int, T)) {
+	v.Snapshot().TrackEach(consumer)
+}
+
+// ForEach applies the 'consumer' function to every element of the current snapshot
+func (v *Vector[T]) ForEach(consumer func(T)) {
+	v.Snapshot().ForEach(consumer)
+}
+
+// Add adds elements to the end of the vector
+func (v *Vector[T]) Add(elements ...T) {
+	v.Update(func(vector *mutable.Vector[T]) { vector.Add(elements...) })
+}
+
+// AddOne adds an element to the end of the vector
+func (v *Vector[T]) AddOne(element T) {
+	v.Add(element)
+}
+
+// AddAll adds all elements from the "other" seq to the end of the vector
+func (v *Vector[T]) AddAll(other seq.Seq[T]) {
+	if other != nil {
+		v.Add(other.Slice()...)
+	}
+}
+
+// DeleteOne removes an element by the index
+func (v *Vector[T]) DeleteOne(index int) {
+	_ = v.DeleteActualOne(index)
+}
+
+// DeleteActualOne removes an element by the index
+func (v *Vector[T]) DeleteActualOne(index int) bool {
+	_, ok := v.Remove(index)
+	return ok
+}
+
+// Remove removes and returns an element by the index
+func (v *Vector[T]) Remove(index int) (t T, ok bool) {
+	v.Update(func(vector *mutable.Vector[T]) { t, ok = vector.Remove(index) })
+	return t, ok
+}
+
+// Delete drops elements by indexes
+func (v *Vector[T]) Delete(indexes ...int) {
+	v.DeleteActual(indexes...)
+}
+
+// DeleteActual drops elements by indexes with verification of no-op
+func (v *Vector[T]) DeleteActual(indexes ...int) (ok bool) {
+	v.Update(func(vector *mutable.Vector[T]) { ok = vector.DeleteActual(indexes...) })
+	return ok
+}
+
+// Set puts an element into the vector at the index
+func (v *Vector[T]) Set(index int, value T) {
+	v.SetNew(index, value)
+}
+
+// SetNew puts an element into the vector at the index
+func (v *Vector[T]) SetNew(index int, value T) (ok bool) {
+	v.Update(func(vector *mutable.Vector[T]) { ok = vector.SetNew(index, value) })
+	return ok
+}
+
+// Filter returns a seq consisting of vector elements matching the filter
+func (v *Vector[T]) Filter(filter func(T) bool) seq.Seq[T] {
+	return v.Snapshot().Filter(filter)
+}
+
+// Filt returns an errorable seq consisting of elements that satisfy the condition of the 'filter' function
+func (v *Vector[T]) Filt(filter func(T) (bool, error)) seq.SeqE[T] {
+	return v.Snapshot().Filt(filter)
+}
+
+// Convert returns a seq that applies the 'converter' function to the collection elements
+func (v *Vector[T]) Convert(converter func(T) T) seq.Seq[T] {
+	return v.Snapshot().Convert(converter)
+}
+
+// Conv returns an errorable seq that applies the 'converter' function to the collection elements
+func (v *Vector[T]) Conv(converter func(T) (T, error)) seq.SeqE[T] {
+	return v.Snapshot().Conv(converter)
+}
+
+// Reduce reduces the elements into an one using the 'merge' function
+func (v *Vector[T]) Reduce(merge func(T, T) T) T {
+	return v.Snapshot().Reduce(merge)
+}
+
+// HasAny checks whether the vector contains an element that satisfies the condition.
+func (v *Vector[T]) HasAny(condition func(T) bool) bool {
+	return v.Snapshot().HasAny(condition)
+}
+
+// First returns the first element that satisfies requirements of the condition.
+func (v *Vector[T]) First(condition func(T) bool) (T, bool) {
+	return v.Snapshot().First(condition)
+}
+
+// Sort sorts a copy of the vector and publishes it as a new snapshot
+func (v *Vector[T]) Sort(comparer slice.Comparer[T]) *Vector[T] {
+	v.Update(func(vector *mutable.Vector[T]) { vector.Sort(comparer) })
+	return v
+}
+
+// StableSort stable sorts a copy of the vector and publishes it as a new snapshot
+func (v *Vector[T]) StableSort(comparer slice.Comparer[T]) *Vector[T] {
+	v.Update(func(vector *mutable.Vector[T]) { vector.StableSort(comparer) })
+	return v
+}
+
+// String returns then string representation
+func (v *Vector[T]) String() string {
+	return v.Snapshot().String()
+}
+
+func (v *Vector[T]) store(snapshot immutable.Vector[T]) {
+	v.snapshot.Store(&snapshot)
+}
+
+// MarshalJSON encodes the current snapshot of the vector
+func (v *Vector[T]) MarshalJSON() ([]byte, error) {
+	return v.Snapshot().MarshalJSON()
+}
+
+// UnmarshalJSON decodes a new snapshot of the vector and publishes it
+func (v *Vector[T]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	var snapshot immutable.Vector[T]
+	if err := snapshot.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.store(snapshot)
+	return nil
+}
@@ -0,0 +1,78 @@
+package mutable
+
+import (
+	"bytes"
+	"errors"
+	"hash/maphash"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrNoHasher is returned when a HashMap or a HashSet without the hasher functions is unmarshaled
+var ErrNoHasher = errors.New("hasher is not defined")
+
+// Hasher defines the hash and the equality functions of keys that cannot be compared by the == operator.
+// Equal keys must have equal hashes.
+type Hasher[K any] struct {
+	Hash  func(K) uint64
+	Equal func(K, K) bool
+}
+
+func (h Hasher[K]) defined() bool {
+	return h.Hash != nil && h.Equal != nil
+}
+
+// BytesHasher returns a hasher of byte slices based on the hash/maphash package with a random seed
+func BytesHasher() Hasher[[]byte] {
+	seed := maphash.MakeSeed()
+	return Hasher[[]byte]{
+		Hash:  func(key []byte) uint64 { return maphash.Bytes(seed, key) },
+		Equal: bytes.Equal,
+	}
+}
+
+// SliceHasher returns a hasher of slices of comparable elements based on the hash/maphash package with a random seed
+func SliceHasher[T comparable]() Hasher[[]T] {
+	seed := maphash.MakeSeed()
+	return Hasher[[]T]{
+		Hash: func(key []T) uint64 {
+			var h maphash.Hash
+			h.SetSeed(seed)
+			for _, e := range key {
+				maphash.WriteComparable(&h, e)
+			}
+			return h.Sum64()
+		},
+		Equal: slices.Equal[[]T],
+	}
+}
+
+// FoldHasher returns a hasher of strings that are compared under Unicode case-folding, as by the strings.EqualFold function
+func FoldHasher() Hasher[string] {
+	seed := maphash.MakeSeed()
+	return Hasher[string]{
+		Hash: func(key string) uint64 {
+			var (
+				h   maphash.Hash
+				buf [utf8.UTFMax]byte
+			)
+			h.SetSeed(seed)
+			for _, r := range key {
+				_, _ = h.Write(utf8.AppendRune(buf[:0], foldRune(r)))
+			}
+			return h.Sum64()
+		},
+		Equal: strings.EqualFold,
+	}
+}
+
+// foldRune returns the smallest rune of the case-folding orbit of the specified one
+func foldRune(r rune) rune {
+	least := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		least = min(least, f)
+	}
+	return least
+}
@@ -0,0 +1,321 @@
+package mutable
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	converte "github.com/m4gshm/gollections/break/kv/convert"
+	filtere "github.com/m4gshm/gollections/break/kv/predicate"
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/kv/convert"
+	kvfilter "github.com/m4gshm/gollections/kv/predicate"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/seq2"
+)
+
+// HashMap is a map implementation that uses the custom hash and equality functions of keys instead of the == operator.
+// It allows to use non-comparable keys like slices, or to compare keys by a custom rule.
+// It must be created by the NewHashMap function that specifies the hasher.
+// Setting a value to a map without the hasher panics with ErrNoHasher.
+type HashMap[K, V any] struct {
+	buckets map[uint64][]c.KV[K, V]
+	size    int
+	hasher  Hasher[K]
+}
+
+var (
+	_ c.Deleteable[[]byte]                    = (*HashMap[[]byte, any])(nil)
+	_ c.Removable[[]byte, any]                = (*HashMap[[]byte, any])(nil)
+	_ c.Settable[[]byte, any]                 = (*HashMap[[]byte, any])(nil)
+	_ c.SettableNew[[]byte, any]              = (*HashMap[[]byte, any])(nil)
+	_ c.SettableMap[c.TrackEach[[]byte, any]] = (*HashMap[[]byte, any])(nil)
+	_ c.Checkable[[]byte]                     = (*HashMap[[]byte, any])(nil)
+	_ c.Access[[]byte, any]                   = (*HashMap[[]byte, any])(nil)
+	_ c.KVRange[[]byte, any]                  = (*HashMap[[]byte, any])(nil)
+	_ c.TrackEach[[]byte, any]                = (*HashMap[[]byte, any])(nil)
+	_ fmt.Stringer                            = (*HashMap[[]byte, any])(nil)
+	_ json.Marshaler                          = (*HashMap[[]byte, any])(nil)
+	_ json.Unmarshaler                        = (*HashMap[[]byte, any])(nil)
+)
+
+// All is used to iterate through the collection using `for key, val := range`.
+func (m *HashMap[K, V]) All(consumer func(K, V) bool) {
+	if m == nil {
+		return
+	}
+	for _, bucket := range m.buckets {
+		for _, e := range bucket {
+			if !consumer(e.K, e.V) {
+				return
+			}
+		}
+	}
+}
+
+// Head returns the first key\value pair.
+func (m *HashMap[K, V]) Head() (K, V, bool) {
+	return seq2.Head(m.All)
+}
+
+// Len returns amount of elements
+func (m *HashMap[K, V]) Len() int {
+	if m == nil {
+		return 0
+	}
+	return m.size
+}
+
+// IsEmpty returns true if the map is empty
+func (m *HashMap[K, V]) IsEmpty() bool {
+	return m.Len() == 0
+}
+
+// TrackEach applies the 'consumer' function for every key/value pairs
+func (m *HashMap[K, V]) TrackEach(consumer func(K, V)) {
+	for key, value := range m.All {
+		consumer(key, value)
+	}
+}
+
+// Contains checks is the map contains a key
+func (m *HashMap[K, V]) Contains(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Get returns the value for a key.
+// If ok==false, then the map does not contain the key.
+func (m *HashMap[K, V]) Get(key K) (value V, ok bool) {
+	if m.IsEmpty() {
+		return value, false
+	}
+	bucket := m.buckets[m.hasher.Hash(key)]
+	if i := m.index(bucket, key); i >= 0 {
+		return bucket[i].V, true
+	}
+	return value, false
+}
+
+// Set sets the value for a key
+func (m *HashMap[K, V]) Set(key K, value V) {
+	if m == nil {
+		return
+	} else if !m.hasher.defined() {
+		panic(ErrNoHasher)
+	} else if m.buckets == nil {
+		m.buckets = map[uint64][]c.KV[K, V]{}
+	}
+	hash := m.hasher.Hash(key)
+	bucket := m.buckets[hash]
+	if i := m.index(bucket, key); i >= 0 {
+		bucket[i].V = value
+	} else {
+		m.buckets[hash] = append(bucket, c.KV[K, V]{K: key, V: value})
+		m.size++
+	}
+}
+
+// SetNew sets the value for a key only if the key is not exists in the map
+func (m *HashMap[K, V]) SetNew(key K, value V) bool {
+	if m == nil || m.Contains(key) {
+		return false
+	}
+	m.Set(key, value)
+	return true
+}
+
+// SetMap inserts all elements from the 'other' map
+func (m *HashMap[K, V]) SetMap(other c.TrackEach[K, V]) {
+	if m == nil || other == nil {
+		return
+	}
+	other.TrackEach(m.Set)
+}
+
+// Delete removes value by their keys from the map
+func (m *HashMap[K, V]) Delete(keys ...K) {
+	for _, key := range keys {
+		m.DeleteOne(key)
+	}
+}
+
+// DeleteOne removes a value by the key from the map
+func (m *HashMap[K, V]) DeleteOne(key K) {
+	_, _ = m.Remove(key)
+}
+
+// Remove removes value by key and return it
+func (m *HashMap[K, V]) Remove(key K) (value V, ok bool) {
+	if m.IsEmpty() {
+		return value, false
+	}
+	hash := m.hasher.Hash(key)
+	bucket := m.buckets[hash]
+	i := m.index(bucket, key)
+	if i < 0 {
+		return value, false
+	}
+	value = bucket[i].V
+	if len(bucket) == 1 {
+		delete(m.buckets, hash)
+	} else {
+		last := len(bucket) - 1
+		bucket[i] = bucket[last]
+		bucket[last] = c.KV[K, V]{}
+		m.buckets[hash] = bucket[:last]
+	}
+	m.size--
+	return value, true
+}
+
+// Keys returns a seq of the map keys
+func (m *HashMap[K, V]) Keys() seq.Seq[K] {
+	return seq2.Keys(m.All)
+}
+
+// Values returns a seq of the map values
+func (m *HashMap[K, V]) Values() seq.Seq[V] {
+	return seq2.Values(m.All)
+}
+
+// Clone returns copy of the map
+func (m *HashMap[K, V]) Clone() *HashMap[K, V] {
+	if m == nil {
+		return nil
+	}
+	out := &HashMap[K, V]{size: m.size, hasher: m.hasher}
+	if m.buckets != nil {
+		out.buckets = make(map[uint64][]c.KV[K, V], len(m.buckets))
+		for hash, bucket := range m.buckets {
+			out.buckets[hash] = append([]c.KV[K, V](nil), bucket...)
+		}
+	}
+	return out
+}
+
+// String string representation on the map
+func (m *HashMap[K, V]) String() string {
+	str := strings.Builder{}
+	str.WriteString("[")
+	i := 0
+	for key, value := range m.All {
+		if i > 0 {
+			str.WriteString(" ")
+		}
+		str.WriteString(fmt.Sprintf("%+v:%+v", key, value))
+		i++
+	}
+	str.WriteString("]")
+	return str.String()
+}
+
+// FilterKey returns a seq consisting of key/value pairs where the key satisfies the condition of the 'filter' function
+func (m *HashMap[K, V]) FilterKey(filter func(K) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, kvfilter.Key[V](filter))
+}
+
+// FiltKey returns an errorable seq consisting of key/value pairs where the key satisfies the condition of the 'filter' function
+func (m *HashMap[K, V]) FiltKey(filter func(K) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, filtere.Key[V](filter))
+}
+
+// ConvertKey returns a seq that applies the 'converter' function to keys of the map
+func (m *HashMap[K, V]) ConvertKey(converter func(K) K) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, convert.Key[V](converter))
+}
+
+// ConvKey returns an errorable seq that applies the 'converter' function to keys of the map
+func (m *HashMap[K, V]) ConvKey(converter func(K) (K, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converte.Key[V](converter))
+}
+
+// FilterValue returns a seq consisting of key/value pairs where the value satisfies the condition of the 'filter' function
+func (m *HashMap[K, V]) FilterValue(filter func(V) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, kvfilter.Value[K](filter))
+}
+
+// FiltValue returns an errorable seq consisting of key/value pairs where the value satisfies the condition of the 'filter' function
+func (m *HashMap[K, V]) FiltValue(filter func(V) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, filtere.Value[K](filter))
+}
+
+// ConvertValue returns a seq that applies the 'converter' function to values of the map
+func (m *HashMap[K, V]) ConvertValue(converter func(V) V) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, convert.Value[K](converter))
+}
+
+// ConvValue returns an errorable seq that applies the 'converter' function to values of the map
+func (m *HashMap[K, V]) ConvValue(converter func(V) (V, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converte.Value[K](converter))
+}
+
+// Filter returns a seq consisting of elements that satisfy the condition of the 'filter' function
+func (m *HashMap[K, V]) Filter(filter func(K, V) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, filter)
+}
+
+// Filt returns an errorable seq consisting of elements that satisfy the condition of the 'filter' function
+func (m *HashMap[K, V]) Filt(filter func(K, V) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, filter)
+}
+
+// Convert returns a seq that applies the 'converter' function to the collection elements
+func (m *HashMap[K, V]) Convert(converter func(K, V) (K, V)) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, converter)
+}
+
+// Conv returns an errorable seq that applies the 'converter' function to the collection elements
+func (m *HashMap[K, V]) Conv(converter func(K, V) (K, V, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converter)
+}
+
+// Reduce reduces the key/value pairs of the map into an one pair using the 'merge' function
+func (m *HashMap[K, V]) Reduce(merge func(K, K, V, V) (K, V)) (rk K, rv V) {
+	first := true
+	for key, value := range m.All {
+		if first {
+			rk, rv = key, value
+			first = false
+		} else {
+			rk, rv = merge(rk, key, rv, value)
+		}
+	}
+	return rk, rv
+}
+
+// HasAny checks whether the map contains a key\value pair that satisfies the condition.
+func (m *HashMap[K, V]) HasAny(condition func(K, V) bool) bool {
+	return seq2.HasAny(m.All, condition)
+}
+
+func (m *HashMap[K, V]) index(bucket []c.KV[K, V], key K) int {
+	for i := range bucket {
+		if m.hasher.Equal(bucket[i].K, key) {
+			return i
+		}
+	}
+	return -1
+}
+
+// MarshalJSON encodes the map as a JSON object
+func (m *HashMap[K, V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalSortedObject(m.All)
+}
+
+// UnmarshalJSON replaces the map content by the members of a JSON object
+func (m *HashMap[K, V]) UnmarshalJSON(data []byte) error {
+	if !m.hasher.defined() {
+		return ErrNoHasher
+	}
+	if json_.IsNull(data) {
+		return nil
+	}
+	pairs, err := json_.UnmarshalPairs[K, V](data)
+	if err != nil {
+		return err
+	}
+	*m = *NewHashMap(m.hasher, pairs...)
+	return nil
+}
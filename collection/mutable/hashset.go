@@ -0,0 +1,234 @@
+package mutable
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/slice"
+)
+
+// HashSet is a set implementation that uses the custom hash and equality functions of elements instead of the == operator.
+// It allows to use non-comparable elements like slices, or to compare elements by a custom rule.
+// It must be created by the NewHashSet function that specifies the hasher.
+// Adding an element to a set without the hasher panics with ErrNoHasher.
+type HashSet[T any] struct {
+	elements HashMap[T, struct{}]
+}
+
+var (
+	_ c.Addable[[]byte]                = (*HashSet[[]byte])(nil)
+	_ c.AddableNew[[]byte]             = (*HashSet[[]byte])(nil)
+	_ c.AddableAll[seq.Seq[[]byte]]    = (*HashSet[[]byte])(nil)
+	_ c.AddableAllNew[seq.Seq[[]byte]] = (*HashSet[[]byte])(nil)
+	_ c.Deleteable[[]byte]             = (*HashSet[[]byte])(nil)
+	_ c.DeleteableVerify[[]byte]       = (*HashSet[[]byte])(nil)
+	_ c.Checkable[[]byte]              = (*HashSet[[]byte])(nil)
+	_ collection.Collection[[]byte]    = (*HashSet[[]byte])(nil)
+	_ fmt.Stringer                     = (*HashSet[[]byte])(nil)
+	_ json.Marshaler                   = (*HashSet[[]byte])(nil)
+	_ json.Unmarshaler                 = (*HashSet[[]byte])(nil)
+)
+
+// All is used to iterate through the collection using `for e := range`.
+func (s *HashSet[T]) All(consumer func(T) bool) {
+	if s != nil {
+		s.elements.All(func(e T, _ struct{}) bool { return consumer(e) })
+	}
+}
+
+// Head returns the first element.
+func (s *HashSet[T]) Head() (T, bool) {
+	return collection.Head(s)
+}
+
+// Slice collects the elements to a slice
+func (s *HashSet[T]) Slice() []T {
+	return s.Append(nil)
+}
+
+// Append collects the values to the specified 'out' slice
+func (s *HashSet[T]) Append(out []T) []T {
+	for e := range s.All {
+		out = append(out, e)
+	}
+	return out
+}
+
+// Clone returns copy of the collection
+func (s *HashSet[T]) Clone() *HashSet[T] {
+	if s == nil {
+		return nil
+	}
+	return &HashSet[T]{elements: *s.elements.Clone()}
+}
+
+// IsEmpty returns true if the collection is empty
+func (s *HashSet[T]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// Len returns amount of the elements
+func (s *HashSet[T]) Len() int {
+	if s == nil {
+		return 0
+	}
+	return s.elements.Len()
+}
+
+// Contains checks if the collection contains an element
+func (s *HashSet[T]) Contains(element T) bool {
+	return s != nil && s.elements.Contains(element)
+}
+
+// Add adds elements in the collection
+func (s *HashSet[T]) Add(elements ...T) {
+	for _, element := range elements {
+		s.AddOne(element)
+	}
+}
+
+// AddOne adds an element in the collection
+func (s *HashSet[T]) AddOne(element T) {
+	if s != nil {
+		s.elements.Set(element, struct{}{})
+	}
+}
+
+// AddNew inserts elements if they are not contained in the collection
+func (s *HashSet[T]) AddNew(elements ...T) bool {
+	ok := false
+	for _, element := range elements {
+		ok = s.AddOneNew(element) || ok
+	}
+	return ok
+}
+
+// AddOneNew inserts an element if it is not contained in the collection
+func (s *HashSet[T]) AddOneNew(element T) bool {
+	return s != nil && s.elements.SetNew(element, struct{}{})
+}
+
+// AddAll inserts all elements from the "other" sequence.
+func (s *HashSet[T]) AddAll(elements seq.Seq[T]) {
+	if s != nil && elements != nil {
+		seq.ForEach(elements, s.AddOne)
+	}
+}
+
+// AddAllNew inserts elements from the "other" sequence if they are not contained in the collection.
+func (s *HashSet[T]) AddAllNew(other seq.Seq[T]) (ok bool) {
+	if s != nil && other != nil {
+		seq.ForEach(other, func(element T) { ok = s.AddOneNew(element) || ok })
+	}
+	return ok
+}
+
+// Delete removes elements from the collection
+func (s *HashSet[T]) Delete(elements ...T) {
+	for _, element := range elements {
+		s.DeleteOne(element)
+	}
+}
+
+// DeleteOne removes an element from the collection
+func (s *HashSet[T]) DeleteOne(element T) {
+	_ = s.DeleteActualOne(element)
+}
+
+// DeleteActual removes elements only if they are contained in the collection
+func (s *HashSet[T]) DeleteActual(elements ...T) bool {
+	ok := false
+	for _, element := range elements {
+		ok = s.DeleteActualOne(element) || ok
+	}
+	return ok
+}
+
+// DeleteActualOne removes an element only if it is contained in the collection
+func (s *HashSet[T]) DeleteActualOne(element T) (ok bool) {
+	if s != nil {
+		_, ok = s.elements.Remove(element)
+	}
+	return ok
+}
+
+// ForEach applies the 'consumer' function for every element
+func (s *HashSet[T]) ForEach(consumer func(T)) {
+	for e := range s.All {
+		consumer(e)
+	}
+}
+
+// Filter returns a seq that checks elements by the 'filter' function and returns successful ones.
+func (s *HashSet[T]) Filter(filter func(T) bool) seq.Seq[T] {
+	return collection.Filter(s, filter)
+}
+
+// Filt returns an errorable seq consisting of elements that satisfy the condition of the 'filter' function
+func (s *HashSet[T]) Filt(filter func(T) (bool, error)) seq.SeqE[T] {
+	return collection.Filt(s, filter)
+}
+
+// Convert returns a seq that applies the 'converter' function to the collection elements
+func (s *HashSet[T]) Convert(converter func(T) T) seq.Seq[T] {
+	return collection.Convert(s, converter)
+}
+
+// Conv returns an errorable seq that applies the 'converter' function to the collection elements
+func (s *HashSet[T]) Conv(converter func(T) (T, error)) seq.SeqE[T] {
+	return collection.Conv(s, converter)
+}
+
+// Reduce reduces the elements into an one using the 'merge' function
+func (s *HashSet[T]) Reduce(merge func(T, T) T) T {
+	return collection.Reduce(s, merge)
+}
+
+// HasAny checks whether the set contains an element that satisfies the condition.
+func (s *HashSet[T]) HasAny(condition func(T) bool) bool {
+	return seq.HasAny(s.All, condition)
+}
+
+// First returns the element that satisfies the condition.
+func (s *HashSet[T]) First(condition func(T) bool) (T, bool) {
+	return collection.First(s, condition)
+}
+
+// Sort collects the elements to a Vector and sorts it
+func (s *HashSet[T]) Sort(comparer slice.Comparer[T]) *Vector[T] {
+	return WrapVector(slice.Sort(s.Slice(), comparer))
+}
+
+// StableSort collects the elements to a Vector and stable sorts it
+func (s *HashSet[T]) StableSort(comparer slice.Comparer[T]) *Vector[T] {
+	return WrapVector(slice.StableSort(s.Slice(), comparer))
+}
+
+func (s *HashSet[T]) String() string {
+	return slice.ToString(s.Slice())
+}
+
+// MarshalJSON encodes the set as a JSON array
+func (s *HashSet[T]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalArray(s.All)
+}
+
+// UnmarshalJSON replaces the set elements by the elements of a JSON array
+func (s *HashSet[T]) UnmarshalJSON(data []byte) error {
+	if !s.elements.hasher.defined() {
+		return ErrNoHasher
+	}
+	if json_.IsNull(data) {
+		return nil
+	}
+	elements, err := json_.UnmarshalArray[T](data)
+	if err != nil {
+		return err
+	}
+	*s = *NewHashSet(s.elements.hasher, elements...)
+	return nil
+}
@@ -137,6 +137,89 @@ func (m *Map[K, V]) SetNew(key K, value V) bool {
 	return false
 }
 
+// Compute calculates a new value for a key using the 'remapping' function.
+// The function receives the current value and the presence flag, and returns the new value and the presence flag.
+// If the returned flag is false, then the key is deleted.
+func (m *Map[K, V]) Compute(key K, remapping func(value V, ok bool) (V, bool)) (value V, ok bool) {
+	if m == nil {
+		return value, false
+	}
+	old, exists := m.Get(key)
+	if value, ok = remapping(old, exists); ok {
+		m.Set(key, value)
+	} else if exists {
+		m.DeleteOne(key)
+	}
+	return value, ok
+}
+
+// ComputeIfAbsent sets the value calculated by the 'supplier' function only if the key is not exists in the map.
+// Returns the current value of the key.
+func (m *Map[K, V]) ComputeIfAbsent(key K, supplier func(key K) V) V {
+	value, _ := m.Compute(key, func(old V, ok bool) (V, bool) {
+		if ok {
+			return old, true
+		}
+		return supplier(key), true
+	})
+	return value
+}
+
+// ComputeIfPresent calculates a new value for an existing key using the 'remapping' function.
+// If the function returns false, then the key is deleted.
+func (m *Map[K, V]) ComputeIfPresent(key K, remapping func(key K, value V) (V, bool)) (V, bool) {
+	return m.Compute(key, func(old V, ok bool) (V, bool) {
+		if !ok {
+			return old, false
+		}
+		return remapping(key, old)
+	})
+}
+
+// Merge sets the value for a key if the key is absent, or the result of the 'merge' function of the old and the new values otherwise.
+// If the function returns false, then the key is deleted.
+func (m *Map[K, V]) Merge(key K, value V, merge func(old, value V) (V, bool)) (V, bool) {
+	return m.Compute(key, func(old V, ok bool) (V, bool) {
+		if !ok {
+			return value, true
+		}
+		return merge(old, value)
+	})
+}
+
+// Upsert sets the value for a key if the key is absent, or the result of the 'merge' function of the old and the new values otherwise.
+// Returns the stored value.
+func (m *Map[K, V]) Upsert(key K, value V, merge func(old, value V) V) V {
+	if m == nil {
+		return value
+	}
+	if old, ok := m.Get(key); ok {
+		value = merge(old, value)
+	}
+	m.Set(key, value)
+	return value
+}
+
+// GetOrDefault returns the value for a key, or the 'defaultValue' if the map does not contain the key
+func (m *Map[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if value, ok := m.Get(key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+// MergeMap inserts all elements from the 'other' map resolving values of existing keys by the 'resolver' function.
+// The resolver receives the presence flag, the key, the current value and the value of the 'other' map, and returns the value to store.
+func (m *Map[K, V]) MergeMap(other c.TrackEach[K, V], resolver func(exists bool, key K, valResolv V, val V) V) {
+	if m == nil || other == nil {
+		return
+	}
+	other.TrackEach(func(key K, value V) {
+		old, exists := m.Get(key)
+		m.Set(key, resolver(exists, key, old, value))
+	})
+}
+
 // Delete removes value by their keys from the map
 func (m *Map[K, V]) Delete(keys ...K) {
 	for _, key := range keys {
@@ -229,3 +229,58 @@ func Test_Map_Sort(t *testing.T) {
 	assert.Equal(t, ordered.NewMap(k.V(-8, "-8"), k.V(4, "4"), k.V(5, "5"), k.V(10, "10")), o)
 	assert.NotSame(t, m, o)
 }
+
+func Test_Map_Compute(t *testing.T) {
+	counter := map_.Empty[string, int]()
+	for _, word := range slice.Of("a", "b", "a", "c", "a") {
+		counter.Compute(word, func(count int, _ bool) (int, bool) { return count + 1, true })
+	}
+	assert.Equal(t, map[string]int{"a": 3, "b": 1, "c": 1}, counter.Map())
+
+	_, ok := counter.Compute("b", func(int, bool) (int, bool) { return 0, false })
+	assert.False(t, ok)
+	assert.False(t, counter.Contains("b"))
+
+	assert.Equal(t, 3, counter.ComputeIfAbsent("a", func(string) int { return 10 }))
+	assert.Equal(t, 10, counter.ComputeIfAbsent("d", func(string) int { return 10 }))
+
+	v, ok := counter.ComputeIfPresent("a", func(_ string, count int) (int, bool) { return count * 2, true })
+	assert.True(t, ok)
+	assert.Equal(t, 6, v)
+	_, ok = counter.ComputeIfPresent("e", func(string, int) (int, bool) { return 1, true })
+	assert.False(t, ok)
+	assert.False(t, counter.Contains("e"))
+	counter.ComputeIfPresent("c", func(string, int) (int, bool) { return 0, false })
+	assert.False(t, counter.Contains("c"))
+}
+
+func Test_Map_MergeUpsert(t *testing.T) {
+	m := map_.Of(k.V("a", 1))
+	sum := func(old, value int) int { return old + value }
+
+	assert.Equal(t, 3, m.Upsert("a", 2, sum))
+	assert.Equal(t, 5, m.Upsert("b", 5, sum))
+
+	v, ok := m.Merge("a", 1, func(old, value int) (int, bool) { return old + value, true })
+	assert.True(t, ok)
+	assert.Equal(t, 4, v)
+	_, ok = m.Merge("b", 1, func(int, int) (int, bool) { return 0, false })
+	assert.False(t, ok)
+	assert.False(t, m.Contains("b"))
+
+	assert.Equal(t, 4, m.GetOrDefault("a", -1))
+	assert.Equal(t, -1, m.GetOrDefault("b", -1))
+
+	m.MergeMap(map_.Of(k.V("a", 10), k.V("c", 1)), func(exists bool, _ string, old, value int) int {
+		if exists {
+			return old + value
+		}
+		return value
+	})
+	assert.Equal(t, map[string]int{"a": 14, "c": 1}, m.Map())
+
+	var nilMap *mutable.Map[string, int]
+	assert.Equal(t, 1, nilMap.GetOrDefault("a", 1))
+	_, ok = nilMap.Compute("a", func(int, bool) (int, bool) { return 1, true })
+	assert.False(t, ok)
+}
@@ -74,3 +74,22 @@ func MapFromSeq2[K comparable, V any](seq seq.Seq2[K, V]) *Map[K, V] {
 	}
 	return WrapMap(order, uniques)
 }
+
+// NewMultiMap instantiates a multimap using key/value pairs
+func NewMultiMap[K, V comparable](elements ...c.KV[K, V]) *MultiMap[K, V] {
+	m := WrapMultiMap[K, V](nil, map[K][]V{})
+	for _, kv := range elements {
+		m.Put(kv.Key(), kv.Value())
+	}
+	return m
+}
+
+// MultiMapFromSeq2 creates a multimap with key/value pairs retrieved by the seq.
+func MultiMapFromSeq2[K, V comparable](seq seq.Seq2[K, V]) *MultiMap[K, V] {
+	if seq == nil {
+		return nil
+	}
+	m := WrapMultiMap[K, V](nil, map[K][]V{})
+	m.PutAll(seq)
+	return m
+}
@@ -1,6 +1,7 @@
 package ordered
 
 import (
+	"encoding/json"
 	"fmt"
 
 	converte "github.com/m4gshm/gollections/break/kv/convert"
@@ -8,6 +9,7 @@ import (
 	"github.com/m4gshm/gollections/c"
 	"github.com/m4gshm/gollections/collection"
 	"github.com/m4gshm/gollections/collection/immutable/ordered"
+	"github.com/m4gshm/gollections/internal/json_"
 	"github.com/m4gshm/gollections/kv/convert"
 	kvfilter "github.com/m4gshm/gollections/kv/predicate"
 	"github.com/m4gshm/gollections/map_"
@@ -35,6 +37,8 @@ var (
 	_ collection.Map[int, any]                                    = (*Map[int, any])(nil)
 	_ c.KeyVal[ordered.MapKeys[int], ordered.MapValues[int, any]] = (*Map[int, any])(nil)
 	_ fmt.Stringer                                                = (*Map[int, any])(nil)
+	_ json.Marshaler                                              = (*Map[int, any])(nil)
+	_ json.Unmarshaler                                            = (*Map[int, any])(nil)
 )
 
 // All is used to iterate through the collection using `for key, val := range`.
@@ -150,6 +154,96 @@ func (m *Map[K, V]) SetNew(key K, value V) bool {
 	return false
 }
 
+// Compute calculates a new value for a key using the 'remapping' function.
+// The function receives the current value and the presence flag, and returns the new value and the presence flag.
+// If the returned flag is false, then the key is deleted.
+func (m *Map[K, V]) Compute(key K, remapping func(value V, ok bool) (V, bool)) (value V, ok bool) {
+	if m == nil {
+		return value, false
+	}
+	old, exists := m.Get(key)
+	if value, ok = remapping(old, exists); ok {
+		m.Set(key, value)
+	} else if exists {
+		m.deleteKey(key)
+	}
+	return value, ok
+}
+
+// ComputeIfAbsent sets the value calculated by the 'supplier' function only if the key is not exists in the map.
+// Returns the current value of the key.
+func (m *Map[K, V]) ComputeIfAbsent(key K, supplier func(key K) V) V {
+	value, _ := m.Compute(key, func(old V, ok bool) (V, bool) {
+		if ok {
+			return old, true
+		}
+		return supplier(key), true
+	})
+	return value
+}
+
+// ComputeIfPresent calculates a new value for an existing key using the 'remapping' function.
+// If the function returns false, then the key is deleted.
+func (m *Map[K, V]) ComputeIfPresent(key K, remapping func(key K, value V) (V, bool)) (V, bool) {
+	return m.Compute(key, func(old V, ok bool) (V, bool) {
+		if !ok {
+			return old, false
+		}
+		return remapping(key, old)
+	})
+}
+
+// Merge sets the value for a key if the key is absent, or the result of the 'merge' function of the old and the new values otherwise.
+// If the function returns false, then the key is deleted.
+func (m *Map[K, V]) Merge(key K, value V, merge func(old, value V) (V, bool)) (V, bool) {
+	return m.Compute(key, func(old V, ok bool) (V, bool) {
+		if !ok {
+			return value, true
+		}
+		return merge(old, value)
+	})
+}
+
+// Upsert sets the value for a key if the key is absent, or the result of the 'merge' function of the old and the new values otherwise.
+// Returns the stored value.
+func (m *Map[K, V]) Upsert(key K, value V, merge func(old, value V) V) V {
+	if m == nil {
+		return value
+	}
+	if old, ok := m.Get(key); ok {
+		value = merge(old, value)
+	}
+	m.Set(key, value)
+	return value
+}
+
+// GetOrDefault returns the value for a key, or the 'defaultValue' if the map does not contain the key
+func (m *Map[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if value, ok := m.Get(key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+// MergeMap inserts all elements from the 'other' map resolving values of existing keys by the 'resolver' function.
+// The resolver receives the presence flag, the key, the current value and the value of the 'other' map, and returns the value to store.
+func (m *Map[K, V]) MergeMap(other c.TrackEach[K, V], resolver func(exists bool, key K, valResolv V, val V) V) {
+	if m == nil || other == nil {
+		return
+	}
+	other.TrackEach(func(key K, value V) {
+		old, exists := m.Get(key)
+		m.Set(key, resolver(exists, key, old, value))
+	})
+}
+
+func (m *Map[K, V]) deleteKey(key K) {
+	delete(m.elements, key)
+	if _, i := slice.FirstI(m.order, func(k K) bool { return k == key }); i >= 0 {
+		m.order = slice.Delete(m.order, i)
+	}
+}
+
 // Keys resutrns keys collection
 func (m *Map[K, V]) Keys() ordered.MapKeys[K] {
 	var order []K
@@ -282,3 +376,21 @@ func addToMap[K comparable, V any](key K, val V, order []K, uniques map[K]V) []K
 	}
 	return order
 }
+
+// MarshalJSON encodes the map as a JSON object in the order of the keys
+func (m *Map[K, V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalObject(m.All)
+}
+
+// UnmarshalJSON replaces the map content by the members of a JSON object keeping their order
+func (m *Map[K, V]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	pairs, err := json_.UnmarshalPairs[K, V](data)
+	if err != nil {
+		return err
+	}
+	*m = *NewMap(pairs...)
+	return nil
+}
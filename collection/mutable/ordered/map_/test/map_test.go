@@ -197,3 +197,22 @@ func Test_Map_Sort(t *testing.T) {
 	assert.Equal(t, expected, o)
 	assert.Same(t, m, o)
 }
+
+func Test_Map_Compute(t *testing.T) {
+	m := omap.Of(k.V("a", 1), k.V("b", 2), k.V("c", 3))
+
+	m.Compute("b", func(int, bool) (int, bool) { return 0, false })
+	m.ComputeIfAbsent("d", func(string) int { return 4 })
+	m.ComputeIfPresent("a", func(_ string, v int) (int, bool) { return v * 10, true })
+	m.Upsert("b", 20, func(old, value int) int { return old + value })
+
+	assert.Equal(t, slice.Of("a", "c", "d", "b"), m.Keys().Slice())
+	assert.Equal(t, slice.Of(10, 3, 4, 20), m.Values().Slice())
+
+	m.Merge("c", 0, func(int, int) (int, bool) { return 0, false })
+	m.MergeMap(omap.Of(k.V("e", 5), k.V("a", 1)), func(exists bool, _ string, old, value int) int { return old + value })
+
+	assert.Equal(t, slice.Of("a", "d", "b", "e"), m.Keys().Slice())
+	assert.Equal(t, slice.Of(11, 4, 20, 5), m.Values().Slice())
+	assert.Equal(t, 0, m.GetOrDefault("c", 0))
+}
@@ -0,0 +1,270 @@
+package ordered
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/collection/immutable/ordered"
+	"github.com/m4gshm/gollections/internal/json_"
+	kv "github.com/m4gshm/gollections/kv/collection"
+	"github.com/m4gshm/gollections/map_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/seq2"
+	"github.com/m4gshm/gollections/slice"
+)
+
+// WrapMultiMap instantiates an ordered MultiMap using a map of value slices and an order slice as internal storage.
+func WrapMultiMap[K, V comparable](order []K, elements map[K][]V) *MultiMap[K, V] {
+	size := 0
+	for _, values := range elements {
+		size += len(values)
+	}
+	return &MultiMap[K, V]{order: order, elements: elements, size: size}
+}
+
+// MultiMap is a collection implementation that associates several values with one key and guarantees the keys access order.
+type MultiMap[K, V comparable] struct {
+	order    []K
+	elements map[K][]V
+	size     int
+}
+
+var (
+	_ kv.Collection[int, any, map[int][]any]            = (*MultiMap[int, any])(nil)
+	_ c.Access[int, immutable.Vector[any]]              = (*MultiMap[int, any])(nil)
+	_ c.Checkable[int]                                  = (*MultiMap[int, any])(nil)
+	_ c.ImmutableMapConvert[ordered.MultiMap[int, any]] = (*MultiMap[int, any])(nil)
+	_ fmt.Stringer                                      = (*MultiMap[int, any])(nil)
+	_ json.Marshaler                                    = (*MultiMap[int, any])(nil)
+	_ json.Unmarshaler                                  = (*MultiMap[int, any])(nil)
+)
+
+// All is used to iterate through the key/value pairs using `for key, val := range`.
+// A key is repeated for each of its values.
+func (m *MultiMap[K, V]) All(consumer func(K, V) bool) {
+	if m == nil {
+		return
+	}
+	for _, key := range m.order {
+		for _, value := range m.elements[key] {
+			if !consumer(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// Groups returns a seq of keys and their values.
+func (m *MultiMap[K, V]) Groups() seq.Seq2[K, []V] {
+	return func(yield func(K, []V) bool) {
+		if m == nil {
+			return
+		}
+		for _, key := range m.order {
+			if !yield(key, slice.Clone(m.elements[key])) {
+				return
+			}
+		}
+	}
+}
+
+// Head returns the first key\value pair.
+func (m *MultiMap[K, V]) Head() (K, V, bool) {
+	return seq2.Head(m.All)
+}
+
+// Len returns the total amount of values
+func (m *MultiMap[K, V]) Len() int {
+	if m == nil {
+		return 0
+	}
+	return m.size
+}
+
+// KeysLen returns amount of keys
+func (m *MultiMap[K, V]) KeysLen() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.order)
+}
+
+// IsEmpty returns true if the map is empty
+func (m *MultiMap[K, V]) IsEmpty() bool {
+	return m.Len() == 0
+}
+
+// Contains checks is the map contains a key
+func (m *MultiMap[K, V]) Contains(key K) (ok bool) {
+	if m != nil {
+		_, ok = m.elements[key]
+	}
+	return ok
+}
+
+// Get returns the values of a key.
+// If ok==false, then the map does not contain the key.
+func (m *MultiMap[K, V]) Get(key K) (immutable.Vector[V], bool) {
+	if m != nil {
+		if values, ok := m.elements[key]; ok {
+			return immutable.NewVector(values...), true
+		}
+	}
+	return immutable.Vector[V]{}, false
+}
+
+// Put adds the value to the values of a key
+func (m *MultiMap[K, V]) Put(key K, value V) {
+	if m == nil {
+		return
+	} else if m.elements == nil {
+		m.elements = map[K][]V{}
+	}
+	values, ok := m.elements[key]
+	if !ok {
+		m.order = append(m.order, key)
+	}
+	m.elements[key] = append(values, value)
+	m.size++
+}
+
+// PutAll adds all key/value pairs retrieved by the seq
+func (m *MultiMap[K, V]) PutAll(pairs seq.Seq2[K, V]) {
+	if m != nil && pairs != nil {
+		for key, value := range pairs {
+			m.Put(key, value)
+		}
+	}
+}
+
+// Remove removes the first occurrence of the value from the values of a key.
+// Returns false if the value is not found.
+func (m *MultiMap[K, V]) Remove(key K, value V) bool {
+	if m == nil {
+		return false
+	}
+	values, ok := m.elements[key]
+	if !ok {
+		return false
+	}
+	for i, v := range values {
+		if v == value {
+			if values = slice.Delete(values, i); len(values) == 0 {
+				m.deleteKey(key)
+			} else {
+				m.elements[key] = values
+			}
+			m.size--
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveAll removes a key with all its values and returns the values
+func (m *MultiMap[K, V]) RemoveAll(key K) (immutable.Vector[V], bool) {
+	if m != nil {
+		if values, ok := m.elements[key]; ok {
+			m.deleteKey(key)
+			m.size -= len(values)
+			return immutable.WrapVector(values), true
+		}
+	}
+	return immutable.Vector[V]{}, false
+}
+
+func (m *MultiMap[K, V]) deleteKey(key K) {
+	delete(m.elements, key)
+	if _, i := slice.FirstI(m.order, func(k K) bool { return k == key }); i >= 0 {
+		m.order = slice.Delete(m.order, i)
+	}
+}
+
+// Keys resutrns keys collection
+func (m *MultiMap[K, V]) Keys() ordered.MapKeys[K] {
+	var order []K
+	if m != nil {
+		order = m.order
+	}
+	return ordered.WrapKeys(order)
+}
+
+// Map collects the keys and their values into a new map
+func (m *MultiMap[K, V]) Map() (out map[K][]V) {
+	if m != nil {
+		out = map_.DeepClone(m.elements, slice.Clone)
+	}
+	return out
+}
+
+// TrackEach applies the 'consumer' function for every key/value pairs
+func (m *MultiMap[K, V]) TrackEach(consumer func(K, V)) {
+	for key, value := range m.All {
+		consumer(key, value)
+	}
+}
+
+// Filter returns a seq consisting of key/value pairs that satisfy the condition of the 'filter' function
+func (m *MultiMap[K, V]) Filter(filter func(K, V) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, filter)
+}
+
+// Reduce reduces the key/value pairs of the map into an one pair using the 'merge' function
+func (m *MultiMap[K, V]) Reduce(merge func(K, K, V, V) (K, V)) (rk K, rv V) {
+	first := true
+	for key, value := range m.All {
+		if first {
+			rk, rv = key, value
+			first = false
+		} else {
+			rk, rv = merge(rk, key, rv, value)
+		}
+	}
+	return rk, rv
+}
+
+// HasAny checks whether the map contains a key\value pair that satisfies the condition.
+func (m *MultiMap[K, V]) HasAny(condition func(K, V) bool) bool {
+	return seq2.HasAny(m.All, condition)
+}
+
+// Immutable converts to an immutable multimap instance
+func (m *MultiMap[K, V]) Immutable() ordered.MultiMap[K, V] {
+	var order []K
+	if m != nil {
+		order = slice.Clone(m.order)
+	}
+	return ordered.WrapMultiMap(order, m.Map())
+}
+
+// String string representation on the map
+func (m *MultiMap[K, V]) String() string {
+	var (
+		order    []K
+		elements map[K][]V
+	)
+	if m != nil {
+		order, elements = m.order, m.elements
+	}
+	return map_.ToStringOrdered(order, elements)
+}
+
+// MarshalJSON encodes the multimap as a JSON object of value arrays in the order of the keys
+func (m *MultiMap[K, V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalObject(m.Groups())
+}
+
+// UnmarshalJSON replaces the multimap content by the members of a JSON object of value arrays keeping their order
+func (m *MultiMap[K, V]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	pairs, err := json_.UnmarshalGroups[K, V](data)
+	if err != nil {
+		return err
+	}
+	*m = *NewMultiMap(pairs...)
+	return nil
+}
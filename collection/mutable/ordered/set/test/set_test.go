@@ -232,3 +232,36 @@ func Test_Set_Sort(t *testing.T) {
 	ssorted := ints.StableSort(op.Compare)
 	assert.Equal(t, expected, ssorted)
 }
+
+func Test_Set_Algebra(t *testing.T) {
+	a, b := set.Of(3, 1, 2), set.Of(4, 2, 5)
+
+	assert.Equal(t, slice.Of(3, 1, 2, 4, 5), a.Union(b).Slice())
+	assert.Equal(t, slice.Of(2), a.Intersection(b).Slice())
+	assert.Equal(t, slice.Of(3, 1), a.Difference(b).Slice())
+	assert.Equal(t, slice.Of(3, 1, 4, 5), a.SymmetricDifference(b).Slice())
+
+	assert.True(t, set.Of(2, 3).IsSubsetOf(a))
+	assert.True(t, a.IsSupersetOf(set.Of(1)))
+	assert.True(t, a.IsDisjoint(set.Of(7)))
+	assert.True(t, a.Equal(set.Of(1, 2, 3)))
+	assert.False(t, a.Equal(set.Of(1, 2)))
+}
+
+func Test_Set_RetainRemoveAll(t *testing.T) {
+	s := set.Of(5, 1, 4, 2, 3)
+	s.Retain(set.Of(1, 2, 3, 4))
+	assert.Equal(t, slice.Of(1, 4, 2, 3), s.Slice())
+
+	s.RemoveAll(set.Of(4))
+	assert.Equal(t, slice.Of(1, 2, 3), s.Slice())
+
+	s.Add(4)
+	s.DeleteOne(2)
+	assert.Equal(t, slice.Of(1, 3, 4), s.Slice())
+	assert.True(t, s.Contains(4))
+
+	var nilSet *ordered.Set[int]
+	nilSet.Retain(s)
+	nilSet.RemoveAll(s)
+}
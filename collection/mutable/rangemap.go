@@ -0,0 +1,317 @@
+package mutable
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/seq"
+)
+
+// Interval is a half-open range of keys that includes the From key and excludes the To key.
+type Interval[K cmp.Ordered] struct {
+	From, To K
+}
+
+// Contains checks whether the interval contains a point
+func (i Interval[K]) Contains(point K) bool {
+	return i.From <= point && point < i.To
+}
+
+func (i Interval[K]) String() string {
+	return fmt.Sprintf("[%v, %v)", i.From, i.To)
+}
+
+type rangeEntry[K cmp.Ordered, V comparable] struct {
+	Interval[K]
+	value V
+}
+
+// rangeValue is the JSON form of an interval with a value
+type rangeValue[K cmp.Ordered, V any] struct {
+	From, To K
+	Value    V
+}
+
+// RangeMap is a collection implementation that associates values with non-overlapping key intervals.
+// The intervals are kept in ascending order. Adjacent intervals with equal values are coalesced into one.
+type RangeMap[K cmp.Ordered, V comparable] struct {
+	entries []rangeEntry[K, V]
+}
+
+var (
+	_ c.Access[int, any]            = (*RangeMap[int, any])(nil)
+	_ c.Checkable[int]              = (*RangeMap[int, any])(nil)
+	_ c.KVRange[Interval[int], any] = (*RangeMap[int, any])(nil)
+	_ fmt.Stringer                  = (*RangeMap[int, any])(nil)
+	_ fmt.Stringer                  = Interval[int]{}
+	_ json.Marshaler                = (*RangeMap[int, any])(nil)
+	_ json.Unmarshaler              = (*RangeMap[int, any])(nil)
+)
+
+// All is used to iterate through the intervals and their values in ascending order using `for interval, val := range`.
+func (m *RangeMap[K, V]) All(consumer func(Interval[K], V) bool) {
+	if m == nil {
+		return
+	}
+	for _, e := range m.entries {
+		if !consumer(e.Interval, e.value) {
+			return
+		}
+	}
+}
+
+// Len returns amount of intervals
+func (m *RangeMap[K, V]) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.entries)
+}
+
+// IsEmpty returns true if the map is empty
+func (m *RangeMap[K, V]) IsEmpty() bool {
+	return m.Len() == 0
+}
+
+// Get returns the value of the interval that contains the point.
+// If ok==false, then no interval contains the point.
+func (m *RangeMap[K, V]) Get(point K) (value V, ok bool) {
+	if m != nil {
+		if i := m.after(point); i < len(m.entries) && m.entries[i].From <= point {
+			return m.entries[i].value, true
+		}
+	}
+	return value, false
+}
+
+// Contains checks whether an interval of the map contains the point
+func (m *RangeMap[K, V]) Contains(point K) bool {
+	_, ok := m.Get(point)
+	return ok
+}
+
+// Overlapping returns a seq of the intervals and values that overlap the [from, to) range.
+// The intervals are returned unclipped. The seq is empty if from >= to.
+func (m *RangeMap[K, V]) Overlapping(from, to K) seq.Seq2[Interval[K], V] {
+	return func(yield func(Interval[K], V) bool) {
+		if m == nil || !(from < to) {
+			return
+		}
+		for i := m.after(from); i < len(m.entries) && m.entries[i].From < to; i++ {
+			if e := m.entries[i]; !yield(e.Interval, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Put associates the value with the [from, to) range.
+// Overlapped parts of existing intervals are replaced, and adjacent intervals with the equal value are merged.
+// The range is ignored if from >= to.
+func (m *RangeMap[K, V]) Put(from, to K, value V) {
+	if m == nil || !(from < to) {
+		return
+	}
+	m.cut(from, to)
+	i := m.after(from)
+	e := rangeEntry[K, V]{Interval: Interval[K]{From: from, To: to}, value: value}
+	if i > 0 && m.entries[i-1].To == from && m.entries[i-1].value == value {
+		i--
+		e.From = m.entries[i].From
+		m.entries = slices.Delete(m.entries, i, i+1)
+	}
+	if i < len(m.entries) && m.entries[i].From == to && m.entries[i].value == value {
+		e.To = m.entries[i].To
+		m.entries = slices.Delete(m.entries, i, i+1)
+	}
+	m.entries = slices.Insert(m.entries, i, e)
+}
+
+// DeleteRange removes the [from, to) range from the map, splitting the partially overlapped intervals
+func (m *RangeMap[K, V]) DeleteRange(from, to K) {
+	if m != nil && from < to {
+		m.cut(from, to)
+	}
+}
+
+// Clear removes all intervals
+func (m *RangeMap[K, V]) Clear() {
+	if m != nil {
+		m.entries = nil
+	}
+}
+
+func (m *RangeMap[K, V]) String() string {
+	var out strings.Builder
+	out.WriteString("[")
+	for interval, value := range m.All {
+		if out.Len() > 1 {
+			out.WriteString(" ")
+		}
+		fmt.Fprintf(&out, "%v:%v", interval, value)
+	}
+	out.WriteString("]")
+	return out.String()
+}
+
+// MarshalJSON encodes the map as a JSON array of objects with the From, To and Value fields in ascending order
+func (m *RangeMap[K, V]) MarshalJSON() ([]byte, error) {
+	ranges := []rangeValue[K, V]{}
+	for interval, value := range m.All {
+		ranges = append(ranges, rangeValue[K, V]{From: interval.From, To: interval.To, Value: value})
+	}
+	return json.Marshal(ranges)
+}
+
+// UnmarshalJSON replaces the map content by the ranges of a JSON array put in their order
+func (m *RangeMap[K, V]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	ranges, err := json_.UnmarshalArray[rangeValue[K, V]](data)
+	if err != nil {
+		return err
+	}
+	out := NewRangeMap[K, V]()
+	for _, r := range ranges {
+		out.Put(r.From, r.To, r.Value)
+	}
+	*m = *out
+	return nil
+}
+
+// cut removes the [from, to) range from the intervals
+func (m *RangeMap[K, V]) cut(from, to K) {
+	i := m.after(from)
+	j := i
+	var pieces []rangeEntry[K, V]
+	for ; j < len(m.entries) && m.entries[j].From < to; j++ {
+		e := m.entries[j]
+		if e.From < from {
+			pieces = append(pieces, rangeEntry[K, V]{Interval: Interval[K]{From: e.From, To: from}, value: e.value})
+		}
+		if to < e.To {
+			pieces = append(pieces, rangeEntry[K, V]{Interval: Interval[K]{From: to, To: e.To}, value: e.value})
+		}
+	}
+	if i < j {
+		m.entries = slices.Replace(m.entries, i, j, pieces...)
+	}
+}
+
+// after returns the index of the first interval that ends after the point
+func (m *RangeMap[K, V]) after(point K) int {
+	i, _ := slices.BinarySearchFunc(m.entries, point, func(e rangeEntry[K, V], point K) int {
+		if e.To <= point {
+			return -1
+		}
+		return 1
+	})
+	return i
+}
+
+// RangeSet is a collection of non-overlapping key intervals. Overlapping and adjacent intervals are merged into one.
+type RangeSet[K cmp.Ordered] struct {
+	ranges RangeMap[K, struct{}]
+}
+
+var (
+	_ c.Checkable[int]       = (*RangeSet[int])(nil)
+	_ c.Range[Interval[int]] = (*RangeSet[int])(nil)
+	_ fmt.Stringer           = (*RangeSet[int])(nil)
+	_ json.Marshaler         = (*RangeSet[int])(nil)
+	_ json.Unmarshaler       = (*RangeSet[int])(nil)
+)
+
+// All is used to iterate through the intervals in ascending order using `for interval := range`.
+func (s *RangeSet[K]) All(consumer func(Interval[K]) bool) {
+	if s != nil {
+		s.ranges.All(func(interval Interval[K], _ struct{}) bool { return consumer(interval) })
+	}
+}
+
+// Slice collects the intervals to a slice
+func (s *RangeSet[K]) Slice() []Interval[K] {
+	return seq.Slice(s.All)
+}
+
+// Len returns amount of intervals
+func (s *RangeSet[K]) Len() int {
+	if s == nil {
+		return 0
+	}
+	return s.ranges.Len()
+}
+
+// IsEmpty returns true if the set is empty
+func (s *RangeSet[K]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// Contains checks whether an interval of the set contains the point
+func (s *RangeSet[K]) Contains(point K) bool {
+	return s != nil && s.ranges.Contains(point)
+}
+
+// Overlapping returns a seq of the intervals that overlap the [from, to) range
+func (s *RangeSet[K]) Overlapping(from, to K) seq.Seq[Interval[K]] {
+	return func(yield func(Interval[K]) bool) {
+		if s != nil {
+			for interval := range s.ranges.Overlapping(from, to) {
+				if !yield(interval) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Add adds the [from, to) range to the set
+func (s *RangeSet[K]) Add(from, to K) {
+	if s != nil {
+		s.ranges.Put(from, to, struct{}{})
+	}
+}
+
+// DeleteRange removes the [from, to) range from the set
+func (s *RangeSet[K]) DeleteRange(from, to K) {
+	if s != nil {
+		s.ranges.DeleteRange(from, to)
+	}
+}
+
+func (s *RangeSet[K]) String() string {
+	var out strings.Builder
+	out.WriteString("[")
+	for interval := range s.All {
+		if out.Len() > 1 {
+			out.WriteString(" ")
+		}
+		out.WriteString(interval.String())
+	}
+	out.WriteString("]")
+	return out.String()
+}
+
+// MarshalJSON encodes the set as a JSON array of intervals in ascending order
+func (s *RangeSet[K]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalArray(s.All)
+}
+
+// UnmarshalJSON replaces the set content by the intervals of a JSON array
+func (s *RangeSet[K]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	intervals, err := json_.UnmarshalArray[Interval[K]](data)
+	if err != nil {
+		return err
+	}
+	*s = *NewRangeSet(intervals...)
+	return nil
+}
@@ -1,11 +1,13 @@
 package mutable
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/m4gshm/gollections/c"
 	"github.com/m4gshm/gollections/collection"
 	"github.com/m4gshm/gollections/collection/mutable/ordered"
+	"github.com/m4gshm/gollections/internal/json_"
 	"github.com/m4gshm/gollections/kv/predicate"
 	"github.com/m4gshm/gollections/map_"
 	"github.com/m4gshm/gollections/seq"
@@ -31,6 +33,8 @@ var (
 	_ c.DeleteableVerify[int]       = (*Set[int])(nil)
 	_ collection.Set[int]           = (*Set[int])(nil)
 	_ fmt.Stringer                  = (*Set[int])(nil)
+	_ json.Marshaler                = (*Set[int])(nil)
+	_ json.Unmarshaler              = (*Set[int])(nil)
 )
 
 // All is used to iterate through the collection using `for e := range`.
@@ -257,6 +261,69 @@ func (s *Set[T]) First(condition func(T) bool) (t T, ok bool) {
 	return t, false
 }
 
+// Union returns a new set that contains elements of both sets.
+func (s *Set[T]) Union(other collection.Set[T]) *Set[T] {
+	return SetFromSeq(seq.Union(s.All, other.All))
+}
+
+// Intersection returns a new set that contains elements of the set that are also contained in the 'other' one
+func (s *Set[T]) Intersection(other collection.Set[T]) *Set[T] {
+	return SetFromSeq(s.Filter(other.Contains))
+}
+
+// Difference returns a new set that contains elements of the set that are not contained in the 'other' one
+func (s *Set[T]) Difference(other collection.Set[T]) *Set[T] {
+	return SetFromSeq(s.Filter(func(e T) bool { return !other.Contains(e) }))
+}
+
+// SymmetricDifference returns a new set that contains elements that are contained in only one of the sets.
+func (s *Set[T]) SymmetricDifference(other collection.Set[T]) *Set[T] {
+	return SetFromSeq(seq.Union(
+		s.Filter(func(e T) bool { return !other.Contains(e) }),
+		seq.Filter(other.All, func(e T) bool { return !s.Contains(e) }),
+	))
+}
+
+// Retain removes elements that are not contained in the 'other' set
+func (s *Set[T]) Retain(other collection.Set[T]) {
+	if s != nil {
+		for e := range s.elements {
+			if !other.Contains(e) {
+				delete(s.elements, e)
+			}
+		}
+	}
+}
+
+// RemoveAll removes elements that are contained in the 'other' set
+func (s *Set[T]) RemoveAll(other collection.Set[T]) {
+	if s != nil && s.elements != nil {
+		for e := range other.All {
+			delete(s.elements, e)
+		}
+	}
+}
+
+// IsSubsetOf checks whether all elements of the set are contained in the 'other' one
+func (s *Set[T]) IsSubsetOf(other collection.Set[T]) bool {
+	return s.Len() <= other.Len() && !s.HasAny(func(e T) bool { return !other.Contains(e) })
+}
+
+// IsSupersetOf checks whether the set contains all elements of the 'other' one
+func (s *Set[T]) IsSupersetOf(other collection.Set[T]) bool {
+	return other.Len() <= s.Len() && !other.HasAny(func(e T) bool { return !s.Contains(e) })
+}
+
+// IsDisjoint checks whether the sets have no elements in common
+func (s *Set[T]) IsDisjoint(other collection.Set[T]) bool {
+	return !s.HasAny(other.Contains)
+}
+
+// Equal checks whether the sets contain the same elements regardless of the order
+func (s *Set[T]) Equal(other collection.Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubsetOf(other)
+}
+
 // Sort transforms to the ordered Set contains sorted elements
 func (s *Set[T]) Sort(comparer slice.Comparer[T]) *ordered.Set[T] {
 	if s != nil {
@@ -276,3 +343,21 @@ func (s *Set[T]) StableSort(comparer slice.Comparer[T]) *ordered.Set[T] {
 func (s *Set[T]) String() string {
 	return slice.ToString(s.Slice())
 }
+
+// MarshalJSON encodes the set as a JSON array
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalArray(s.All)
+}
+
+// UnmarshalJSON replaces the set elements by the elements of a JSON array
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	elements, err := json_.UnmarshalArray[T](data)
+	if err != nil {
+		return err
+	}
+	*s = *NewSet(elements...)
+	return nil
+}
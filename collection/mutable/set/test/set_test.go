@@ -215,3 +215,37 @@ func Test_Set_Sort(t *testing.T) {
 	assert.Equal(t, ordered.NewSet(-2, 0, 1, 3, 5, 6, 8), sorted)
 	assert.Equal(t, sorted, ssorted)
 }
+
+func Test_Set_Algebra(t *testing.T) {
+	a, b := set.Of(1, 2, 3), set.Of(3, 4)
+
+	assert.Equal(t, slice.Of(1, 2, 3, 4), sort.Asc(a.Union(b).Slice()))
+	assert.Equal(t, slice.Of(3), a.Intersection(b).Slice())
+	assert.Equal(t, slice.Of(1, 2), sort.Asc(a.Difference(b).Slice()))
+	assert.Equal(t, slice.Of(1, 2, 4), sort.Asc(a.SymmetricDifference(b).Slice()))
+
+	assert.True(t, set.Of(1, 2).IsSubsetOf(a))
+	assert.False(t, a.IsSubsetOf(b))
+	assert.True(t, a.IsSupersetOf(set.Of(1, 3)))
+	assert.True(t, a.IsDisjoint(set.Of(5)))
+	assert.False(t, a.IsDisjoint(b))
+	assert.True(t, a.Equal(ordered.NewSet(3, 2, 1)))
+	assert.False(t, a.Equal(b))
+}
+
+func Test_Set_RetainRemoveAll(t *testing.T) {
+	s := set.Of(1, 2, 3, 4)
+	s.Retain(set.Of(2, 3, 4, 5))
+	assert.Equal(t, slice.Of(2, 3, 4), sort.Asc(s.Slice()))
+
+	s.RemoveAll(set.Of(3, 5))
+	assert.Equal(t, slice.Of(2, 4), sort.Asc(s.Slice()))
+
+	s.RemoveAll(s)
+	assert.True(t, s.IsEmpty())
+
+	var nilSet *mutable.Set[int]
+	nilSet.Retain(s)
+	nilSet.RemoveAll(s)
+	assert.True(t, nilSet.Union(set.Of(1)).Contains(1))
+}
@@ -0,0 +1,263 @@
+package mutable
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/internal/json_"
+	kv "github.com/m4gshm/gollections/kv/collection"
+	"github.com/m4gshm/gollections/map_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/seq2"
+)
+
+// WrapSetMultiMap instantiates SetMultiMap using a map of value sets as internal storage.
+func WrapSetMultiMap[K, V comparable](elements map[K]map[V]struct{}) *SetMultiMap[K, V] {
+	size := 0
+	for _, values := range elements {
+		size += len(values)
+	}
+	return &SetMultiMap[K, V]{elements: elements, size: size}
+}
+
+// SetMultiMap is a collection implementation that associates several unique values with one key.
+type SetMultiMap[K, V comparable] struct {
+	elements map[K]map[V]struct{}
+	size     int
+}
+
+var (
+	_ kv.Collection[int, int, map[int][]int]                 = (*SetMultiMap[int, int])(nil)
+	_ c.Access[int, immutable.Set[int]]                      = (*SetMultiMap[int, int])(nil)
+	_ c.Checkable[int]                                       = (*SetMultiMap[int, int])(nil)
+	_ c.ImmutableMapConvert[immutable.SetMultiMap[int, int]] = (*SetMultiMap[int, int])(nil)
+	_ fmt.Stringer                                           = (*SetMultiMap[int, int])(nil)
+	_ json.Marshaler                                         = (*SetMultiMap[int, int])(nil)
+	_ json.Unmarshaler                                       = (*SetMultiMap[int, int])(nil)
+)
+
+// All is used to iterate through the key/value pairs using `for key, val := range`.
+// A key is repeated for each of its values.
+func (m *SetMultiMap[K, V]) All(consumer func(K, V) bool) {
+	if m == nil {
+		return
+	}
+	for key, values := range m.elements {
+		for value := range values {
+			if !consumer(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// Groups returns a seq of keys and their values.
+func (m *SetMultiMap[K, V]) Groups() seq.Seq2[K, []V] {
+	return func(yield func(K, []V) bool) {
+		if m == nil {
+			return
+		}
+		for key, values := range m.elements {
+			if !yield(key, map_.Keys(values)) {
+				return
+			}
+		}
+	}
+}
+
+// Head returns the first key\value pair.
+func (m *SetMultiMap[K, V]) Head() (K, V, bool) {
+	return seq2.Head(m.All)
+}
+
+// Len returns the total amount of values
+func (m *SetMultiMap[K, V]) Len() int {
+	if m == nil {
+		return 0
+	}
+	return m.size
+}
+
+// KeysLen returns amount of keys
+func (m *SetMultiMap[K, V]) KeysLen() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.elements)
+}
+
+// IsEmpty returns true if the map is empty
+func (m *SetMultiMap[K, V]) IsEmpty() bool {
+	return m.Len() == 0
+}
+
+// Contains checks is the map contains a key
+func (m *SetMultiMap[K, V]) Contains(key K) (ok bool) {
+	if m != nil {
+		_, ok = m.elements[key]
+	}
+	return ok
+}
+
+// ContainsValue checks is the map contains the value of a key
+func (m *SetMultiMap[K, V]) ContainsValue(key K, value V) (ok bool) {
+	if m != nil {
+		if values := m.elements[key]; values != nil {
+			_, ok = values[value]
+		}
+	}
+	return ok
+}
+
+// Get returns the values of a key.
+// If ok==false, then the map does not contain the key.
+func (m *SetMultiMap[K, V]) Get(key K) (immutable.Set[V], bool) {
+	if m != nil {
+		if values, ok := m.elements[key]; ok {
+			return immutable.WrapSet(map_.Clone(values)), true
+		}
+	}
+	return immutable.Set[V]{}, false
+}
+
+// Put adds the value to the values of a key.
+// Returns false if the key already has the value.
+func (m *SetMultiMap[K, V]) Put(key K, value V) bool {
+	if m == nil {
+		return false
+	} else if m.elements == nil {
+		m.elements = map[K]map[V]struct{}{}
+	}
+	values := m.elements[key]
+	if values == nil {
+		values = map[V]struct{}{}
+		m.elements[key] = values
+	} else if _, ok := values[value]; ok {
+		return false
+	}
+	values[value] = struct{}{}
+	m.size++
+	return true
+}
+
+// PutAll adds all key/value pairs retrieved by the seq
+func (m *SetMultiMap[K, V]) PutAll(pairs seq.Seq2[K, V]) {
+	if m != nil && pairs != nil {
+		for key, value := range pairs {
+			m.Put(key, value)
+		}
+	}
+}
+
+// Remove removes the value from the values of a key.
+// Returns false if the value is not found.
+func (m *SetMultiMap[K, V]) Remove(key K, value V) bool {
+	if m == nil {
+		return false
+	}
+	values, ok := m.elements[key]
+	if !ok {
+		return false
+	} else if _, ok = values[value]; !ok {
+		return false
+	}
+	if delete(values, value); len(values) == 0 {
+		delete(m.elements, key)
+	}
+	m.size--
+	return true
+}
+
+// RemoveAll removes a key with all its values and returns the values
+func (m *SetMultiMap[K, V]) RemoveAll(key K) (immutable.Set[V], bool) {
+	if m != nil {
+		if values, ok := m.elements[key]; ok {
+			delete(m.elements, key)
+			m.size -= len(values)
+			return immutable.WrapSet(values), true
+		}
+	}
+	return immutable.Set[V]{}, false
+}
+
+// Keys resutrns keys collection
+func (m *SetMultiMap[K, V]) Keys() immutable.MapKeys[K, map[V]struct{}] {
+	var elements map[K]map[V]struct{}
+	if m != nil {
+		elements = m.elements
+	}
+	return immutable.WrapKeys(elements)
+}
+
+// Map collects the keys and their values into a new map
+func (m *SetMultiMap[K, V]) Map() (out map[K][]V) {
+	if m != nil {
+		out = map_.ConvertValues(m.elements, map_.Keys)
+	}
+	return out
+}
+
+// TrackEach applies the 'consumer' function for every key/value pairs
+func (m *SetMultiMap[K, V]) TrackEach(consumer func(K, V)) {
+	for key, value := range m.All {
+		consumer(key, value)
+	}
+}
+
+// Filter returns a seq consisting of key/value pairs that satisfy the condition of the 'filter' function
+func (m *SetMultiMap[K, V]) Filter(filter func(K, V) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, filter)
+}
+
+// Reduce reduces the key/value pairs of the map into an one pair using the 'merge' function
+func (m *SetMultiMap[K, V]) Reduce(merge func(K, K, V, V) (K, V)) (rk K, rv V) {
+	first := true
+	for key, value := range m.All {
+		if first {
+			rk, rv = key, value
+			first = false
+		} else {
+			rk, rv = merge(rk, key, rv, value)
+		}
+	}
+	return rk, rv
+}
+
+// HasAny checks whether the map contains a key\value pair that satisfies the condition.
+func (m *SetMultiMap[K, V]) HasAny(condition func(K, V) bool) bool {
+	return seq2.HasAny(m.All, condition)
+}
+
+// Immutable converts to an immutable multimap instance
+func (m *SetMultiMap[K, V]) Immutable() immutable.SetMultiMap[K, V] {
+	var elements map[K]map[V]struct{}
+	if m != nil {
+		elements = map_.DeepClone(m.elements, map_.Clone)
+	}
+	return immutable.WrapSetMultiMap(elements)
+}
+
+// String string representation on the map
+func (m *SetMultiMap[K, V]) String() string {
+	return map_.ToString(m.Map())
+}
+
+// MarshalJSON encodes the multimap as a JSON object of value arrays
+func (m *SetMultiMap[K, V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalSortedObject(m.Groups())
+}
+
+// UnmarshalJSON replaces the multimap content by the members of a JSON object of value arrays
+func (m *SetMultiMap[K, V]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	pairs, err := json_.UnmarshalGroups[K, V](data)
+	if err != nil {
+		return err
+	}
+	*m = *NewSetMultiMap(pairs...)
+	return nil
+}
@@ -1,10 +1,21 @@
-// Package sync provides parametrized Map implementation
+// Package sync provides concurrency-safe collection implementations
 package sync
 
 import (
+	"encoding/json"
+	"fmt"
 	"sync"
 
+	converte "github.com/m4gshm/gollections/break/kv/convert"
+	filtere "github.com/m4gshm/gollections/break/kv/predicate"
 	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/kv/convert"
+	kvfilter "github.com/m4gshm/gollections/kv/predicate"
+	"github.com/m4gshm/gollections/map_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/seq2"
 )
 
 // NewMap sync Map constructor
@@ -18,31 +29,134 @@ type Map[K comparable, V any] struct {
 }
 
 var (
-	_ c.Settable[int, any]  = (*Map[int, any])(nil)
-	_ c.Deleteable[int]     = (*Map[int, any])(nil)
-	_ c.Removable[int, any] = (*Map[int, any])(nil)
-	_ c.TrackEach[int, any] = (*Map[int, any])(nil)
-	_ c.Access[int, any]    = (*Map[int, any])(nil)
+	_ c.Settable[int, any]                 = (*Map[int, any])(nil)
+	_ c.SettableNew[int, any]              = (*Map[int, any])(nil)
+	_ c.SettableMap[c.TrackEach[int, any]] = (*Map[int, any])(nil)
+	_ c.Deleteable[int]                    = (*Map[int, any])(nil)
+	_ c.Removable[int, any]                = (*Map[int, any])(nil)
+	_ c.TrackEach[int, any]                = (*Map[int, any])(nil)
+	_ c.Access[int, any]                   = (*Map[int, any])(nil)
+	_ collection.Map[int, any]             = (*Map[int, any])(nil)
+	_ fmt.Stringer                         = (*Map[int, any])(nil)
+	_ json.Marshaler                       = (*Map[int, any])(nil)
+	_ json.Unmarshaler                     = (*Map[int, any])(nil)
 )
 
+// All is used to iterate through the collection using `for key, val := range`.
+// The iteration does not correspond to any consistent snapshot of the map.
+func (m *Map[K, V]) All(consumer func(K, V) bool) {
+	m.m.Range(func(key, value any) bool {
+		return consumer(key.(K), typed[V](value))
+	})
+}
+
+// Keys returns a seq of the map keys
+func (m *Map[K, V]) Keys() seq.Seq[K] {
+	return seq2.Keys(m.All)
+}
+
+// Values returns a seq of the map values
+func (m *Map[K, V]) Values() seq.Seq[V] {
+	return seq2.Values(m.All)
+}
+
+// Head returns the first key\value pair.
+func (m *Map[K, V]) Head() (K, V, bool) {
+	return seq2.Head(m.All)
+}
+
+// Map collects the key/value pairs into a new map
+func (m *Map[K, V]) Map() map[K]V {
+	return seq2.Map(m.All)
+}
+
+// Len returns amount of elements.
+// The map is iterated to count the elements.
+func (m *Map[K, V]) Len() (count int) {
+	m.m.Range(func(_, _ any) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// IsEmpty returns true if the map is empty
+func (m *Map[K, V]) IsEmpty() bool {
+	_, _, ok := m.Head()
+	return !ok
+}
+
 // TrackEach applies the 'consumer' function for every key/value pairs
 func (m *Map[K, V]) TrackEach(traker func(key K, value V)) {
 	m.m.Range(func(key, value any) bool {
-		traker(key.(K), value.(V))
+		traker(key.(K), typed[V](value))
 		return true
 	})
 }
 
+// Contains checks is the map contains a key
+func (m *Map[K, V]) Contains(key K) bool {
+	_, ok := m.m.Load(key)
+	return ok
+}
+
 // Set sets the value for a key
 func (m *Map[K, V]) Set(key K, value V) {
 	m.m.Store(key, value)
 }
 
+// SetNew sets the value for a key only if the key is not exists in the map
+func (m *Map[K, V]) SetNew(key K, value V) bool {
+	_, loaded := m.m.LoadOrStore(key, value)
+	return !loaded
+}
+
+// SetMap inserts all elements from the 'other' map
+func (m *Map[K, V]) SetMap(other c.TrackEach[K, V]) {
+	if other != nil {
+		other.TrackEach(m.Set)
+	}
+}
+
 // Get returns the value for a key.
 // If ok==false, then the map does not contain the key.
 func (m *Map[K, V]) Get(key K) (V, bool) {
 	value, ok := m.m.Load(key)
-	return value.(V), ok
+	return typed[V](value), ok
+}
+
+// LoadOrStore returns the existing value for the key if present.
+// Otherwise, it stores and returns the given value.
+// The loaded result is true if the value was loaded, false if stored.
+func (m *Map[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	rawVal, loaded := m.m.LoadOrStore(key, value)
+	return typed[V](rawVal), loaded
+}
+
+// LoadAndDelete deletes the value for a key, returning the previous value if any.
+// The loaded result reports whether the key was present.
+func (m *Map[K, V]) LoadAndDelete(key K) (value V, loaded bool) {
+	rawVal, loaded := m.m.LoadAndDelete(key)
+	return typed[V](rawVal), loaded
+}
+
+// Swap swaps the value for a key and returns the previous value if any.
+// The loaded result reports whether the key was present.
+func (m *Map[K, V]) Swap(key K, value V) (previous V, loaded bool) {
+	rawVal, loaded := m.m.Swap(key, value)
+	return typed[V](rawVal), loaded
+}
+
+// CompareAndSwap swaps the old and new values for key if the value stored in the map is equal to old.
+// It is a function rather than a method, so only maps of comparable values are accepted.
+func CompareAndSwap[K, V comparable](m *Map[K, V], key K, old, new V) (swapped bool) {
+	return m.m.CompareAndSwap(key, old, new)
+}
+
+// CompareAndDelete deletes the entry for key if its value is equal to old.
+// It is a function rather than a method, so only maps of comparable values are accepted.
+func CompareAndDelete[K, V comparable](m *Map[K, V], key K, old V) (deleted bool) {
+	return m.m.CompareAndDelete(key, old)
 }
 
 // Delete removes value by their keys from the map
@@ -59,6 +173,123 @@ func (m *Map[K, V]) DeleteOne(key K) {
 
 // Remove removes value by key and return it
 func (m *Map[K, V]) Remove(key K) (V, bool) {
-	rawVal, ok := m.m.LoadAndDelete(key)
-	return rawVal.(V), ok
+	return m.LoadAndDelete(key)
+}
+
+// Clear deletes all the entries
+func (m *Map[K, V]) Clear() {
+	m.m.Clear()
+}
+
+// String string representation on the map
+func (m *Map[K, V]) String() string {
+	return map_.ToString(m.Map())
+}
+
+// FilterKey returns a seq consisting of key/value pairs where the key satisfies the condition of the 'filter' function
+func (m *Map[K, V]) FilterKey(filter func(K) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, kvfilter.Key[V](filter))
+}
+
+// FiltKey returns an errorable seq consisting of key/value pairs where the key satisfies the condition of the 'filter' function
+func (m *Map[K, V]) FiltKey(filter func(K) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, filtere.Key[V](filter))
+}
+
+// ConvertKey returns a seq that applies the 'converter' function to keys of the map
+func (m *Map[K, V]) ConvertKey(converter func(K) K) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, convert.Key[V](converter))
+}
+
+// ConvKey returns an errorable seq that applies the 'converter' function to keys of the map
+func (m *Map[K, V]) ConvKey(converter func(K) (K, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converte.Key[V](converter))
+}
+
+// FilterValue returns a seq consisting of key/value pairs where the value satisfies the condition of the 'filter' function
+func (m *Map[K, V]) FilterValue(filter func(V) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, kvfilter.Value[K](filter))
+}
+
+// FiltValue returns an errorable seq consisting of key/value pairs where the value satisfies the condition of the 'filter' function
+func (m *Map[K, V]) FiltValue(filter func(V) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, filtere.Value[K](filter))
+}
+
+// ConvertValue returns a seq that applies the 'converter' function to values of the map
+func (m *Map[K, V]) ConvertValue(converter func(V) V) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, convert.Value[K](converter))
+}
+
+// ConvValue returns an errorable seq that applies the 'converter' function to values of the map
+func (m *Map[K, V]) ConvValue(converter func(V) (V, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converte.Value[K](converter))
+}
+
+// Filter returns a seq consisting of elements that satisfy the condition of the 'filter' function
+func (m *Map[K, V]) Filter(filter func(K, V) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, filter)
+}
+
+// Filt returns an errorable seq consisting of elements that satisfy the condition of the 'filter' function
+func (m *Map[K, V]) Filt(filter func(K, V) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, filter)
+}
+
+// Convert returns a seq that applies the 'converter' function to the collection elements
+func (m *Map[K, V]) Convert(converter func(K, V) (K, V)) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, converter)
+}
+
+// Conv returns an errorable seq that applies the 'converter' function to the collection elements
+func (m *Map[K, V]) Conv(converter func(K, V) (K, V, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converter)
+}
+
+// Reduce reduces the key/value pairs of the map into an one pair using the 'merge' function
+func (m *Map[K, V]) Reduce(merge func(K, K, V, V) (K, V)) (rk K, rv V) {
+	first := true
+	for key, value := range m.All {
+		if first {
+			rk, rv = key, value
+			first = false
+		} else {
+			rk, rv = merge(rk, key, rv, value)
+		}
+	}
+	return rk, rv
+}
+
+// HasAny checks whether the map contains a key\value pair that satisfies the condition.
+func (m *Map[K, V]) HasAny(condition func(K, V) bool) bool {
+	return seq2.HasAny(m.All, condition)
+}
+
+// typed converts a raw value of the sync.Map to the value type.
+// A nil raw value, which is a result of a missing key or a stored nil interface, is converted to the zero value.
+func typed[V any](rawVal any) V {
+	value, _ := rawVal.(V)
+	return value
+}
+
+// MarshalJSON encodes the map as a JSON object sorted by the keys
+func (m *Map[K, V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalSortedObject(m.All)
+}
+
+// UnmarshalJSON replaces the map entries by the members of a JSON object.
+// The replacement is not atomic, concurrent readers may observe a partially replaced map.
+func (m *Map[K, V]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	pairs, err := json_.UnmarshalPairs[K, V](data)
+	if err != nil {
+		return err
+	}
+	m.Clear()
+	for _, kv := range pairs {
+		m.Set(kv.K, kv.V)
+	}
+	return nil
 }
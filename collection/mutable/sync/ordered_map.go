@@ -0,0 +1,255 @@
+package sync
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	converte "github.com/m4gshm/gollections/break/kv/convert"
+	filtere "github.com/m4gshm/gollections/break/kv/predicate"
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection"
+	immutable "github.com/m4gshm/gollections/collection/immutable/ordered"
+	"github.com/m4gshm/gollections/collection/mutable/ordered"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/kv/convert"
+	kvfilter "github.com/m4gshm/gollections/kv/predicate"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/seq2"
+	"github.com/m4gshm/gollections/slice"
+)
+
+// NewOrderedMap instantiates a concurrency-safe ordered map using key/value pairs
+func NewOrderedMap[K comparable, V any](elements ...c.KV[K, V]) *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{elements: *ordered.NewMap(elements...)}
+}
+
+// OrderedMap is a concurrency-safe wrapper of the mutable ordered.Map guarded by a RWMutex.
+// The methods that call user functions work with a snapshot of the map, so the lock is not held while the functions run.
+type OrderedMap[K comparable, V any] struct {
+	mu       sync.RWMutex
+	elements ordered.Map[K, V]
+}
+
+var (
+	_ c.Settable[int, any]                                            = (*OrderedMap[int, any])(nil)
+	_ c.SettableNew[int, any]                                         = (*OrderedMap[int, any])(nil)
+	_ c.SettableMap[c.TrackEach[int, any]]                            = (*OrderedMap[int, any])(nil)
+	_ c.ImmutableMapConvert[immutable.Map[int, any]]                  = (*OrderedMap[int, any])(nil)
+	_ collection.Map[int, any]                                        = (*OrderedMap[int, any])(nil)
+	_ c.KeyVal[immutable.MapKeys[int], immutable.MapValues[int, any]] = (*OrderedMap[int, any])(nil)
+	_ fmt.Stringer                                                    = (*OrderedMap[int, any])(nil)
+	_ json.Marshaler                                                  = (*OrderedMap[int, any])(nil)
+	_ json.Unmarshaler                                                = (*OrderedMap[int, any])(nil)
+)
+
+// Update calls the 'update' function with the wrapped map under the write lock.
+// It is used for multi-step atomic modifications. The map must not be retained after the function returns.
+func (m *OrderedMap[K, V]) Update(update func(*ordered.Map[K, V])) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	update(&m.elements)
+}
+
+// All is used to iterate through a snapshot of the collection using `for key, val := range`.
+func (m *OrderedMap[K, V]) All(consumer func(K, V) bool) {
+	m.Immutable().All(consumer)
+}
+
+// Head returns the first key\value pair.
+func (m *OrderedMap[K, V]) Head() (K, V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.elements.Head()
+}
+
+// Map collects the key/value pairs into a new map
+func (m *OrderedMap[K, V]) Map() map[K]V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.elements.Map()
+}
+
+// Sort sorts keys in-place under the write lock
+func (m *OrderedMap[K, V]) Sort(comparer slice.Comparer[K]) *OrderedMap[K, V] {
+	m.Update(func(elements *ordered.Map[K, V]) { elements.Sort(comparer) })
+	return m
+}
+
+// StableSort sorts keys in-place under the write lock
+func (m *OrderedMap[K, V]) StableSort(comparer slice.Comparer[K]) *OrderedMap[K, V] {
+	m.Update(func(elements *ordered.Map[K, V]) { elements.StableSort(comparer) })
+	return m
+}
+
+// Len returns the amount of elements contained in the map
+func (m *OrderedMap[K, V]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.elements.Len()
+}
+
+// IsEmpty returns true if the map is empty
+func (m *OrderedMap[K, V]) IsEmpty() bool {
+	return m.Len() == 0
+}
+
+// TrackEach applies the 'consumer' function for every key/value pairs of a snapshot
+func (m *OrderedMap[K, V]) TrackEach(consumer func(K, V)) {
+	m.Immutable().TrackEach(consumer)
+}
+
+// Contains checks is the map contains a key
+func (m *OrderedMap[K, V]) Contains(key K) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.elements.Contains(key)
+}
+
+// Get returns the value for a key.
+// If ok==false, then the map does not contain the key.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.elements.Get(key)
+}
+
+// Set sets the value for a key
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.elements.Set(key, value)
+}
+
+// SetNew sets the value fo a key only if the key is not exists in the map
+func (m *OrderedMap[K, V]) SetNew(key K, value V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.elements.SetNew(key, value)
+}
+
+// SetMap inserts all elements from the 'other' map. The elements are collected before locking.
+func (m *OrderedMap[K, V]) SetMap(other c.TrackEach[K, V]) {
+	if other == nil {
+		return
+	}
+	elements := ordered.NewMap[K, V]()
+	elements.SetMap(other)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.elements.SetMap(elements)
+}
+
+// Keys resutrns keys collection of a snapshot
+func (m *OrderedMap[K, V]) Keys() immutable.MapKeys[K] {
+	return m.Immutable().Keys()
+}
+
+// Values resutrns values collection of a snapshot
+func (m *OrderedMap[K, V]) Values() immutable.MapValues[K, V] {
+	return m.Immutable().Values()
+}
+
+// String string representation on the map
+func (m *OrderedMap[K, V]) String() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.elements.String()
+}
+
+// FilterKey returns a seq consisting of key/value pairs where the key satisfies the condition of the 'filter' function
+func (m *OrderedMap[K, V]) FilterKey(filter func(K) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, kvfilter.Key[V](filter))
+}
+
+// FiltKey returns an errorable seq consisting of key/value pairs where the key satisfies the condition of the 'filter' function
+func (m *OrderedMap[K, V]) FiltKey(filter func(K) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, filtere.Key[V](filter))
+}
+
+// ConvertKey returns a seq that applies the 'converter' function to keys of the map
+func (m *OrderedMap[K, V]) ConvertKey(converter func(K) K) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, convert.Key[V](converter))
+}
+
+// ConvKey returns an errorable seq that applies the 'converter' function to keys of the map
+func (m *OrderedMap[K, V]) ConvKey(converter func(K) (K, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converte.Key[V](converter))
+}
+
+// FilterValue returns a seq consisting of key/value pairs where the value satisfies the condition of the 'filter' function
+func (m *OrderedMap[K, V]) FilterValue(filter func(V) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, kvfilter.Value[K](filter))
+}
+
+// FiltValue returns an errorable seq consisting of key/value pairs where the value satisfies the condition of the 'filter' function
+func (m *OrderedMap[K, V]) FiltValue(filter func(V) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, filtere.Value[K](filter))
+}
+
+// ConvertValue returns a seq that applies the 'converter' function to values of the map
+func (m *OrderedMap[K, V]) ConvertValue(converter func(V) V) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, convert.Value[K](converter))
+}
+
+// ConvValue returns an errorable seq that applies the 'converter' function to values of the map
+func (m *OrderedMap[K, V]) ConvValue(converter func(V) (V, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converte.Value[K](converter))
+}
+
+// Filter returns a seq consisting of elements that satisfy the condition of the 'filter' function
+func (m *OrderedMap[K, V]) Filter(filter func(K, V) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, filter)
+}
+
+// Filt returns an errorable seq consisting of elements that satisfy the condition of the 'filter' function
+func (m *OrderedMap[K, V]) Filt(filter func(K, V) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, filter)
+}
+
+// Convert returns a seq that applies the 'converter' function to the collection elements
+func (m *OrderedMap[K, V]) Convert(converter func(K, V) (K, V)) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, converter)
+}
+
+// Conv returns an errorable seq that applies the 'converter' function to the collection elements
+func (m *OrderedMap[K, V]) Conv(converter func(K, V) (K, V, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converter)
+}
+
+// Reduce reduces the key/value pairs of a snapshot into an one pair using the 'merge' function
+func (m *OrderedMap[K, V]) Reduce(merge func(K, K, V, V) (K, V)) (K, V) {
+	return m.Immutable().Reduce(merge)
+}
+
+// HasAny checks whether a snapshot contains a key\value pair that satisfies the condition.
+func (m *OrderedMap[K, V]) HasAny(condition func(K, V) bool) bool {
+	return m.Immutable().HasAny(condition)
+}
+
+// Immutable converts to an immutable map instance
+func (m *OrderedMap[K, V]) Immutable() immutable.Map[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.elements.Immutable()
+}
+
+// MarshalJSON encodes a snapshot of the map as a JSON object keeping the order of the keys
+func (m *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalObject(m.Immutable().All)
+}
+
+// UnmarshalJSON replaces the map entries by the members of a JSON object under the write lock
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	var elements ordered.Map[K, V]
+	if err := elements.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.elements = elements
+	return nil
+}
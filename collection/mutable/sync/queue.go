@@ -0,0 +1,212 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/m4gshm/gollections/collection/mutable"
+	"github.com/m4gshm/gollections/slice"
+)
+
+// ErrClosed is returned by the queue operations performed after the queue is closed
+var ErrClosed = errors.New("queue is closed")
+
+// NewBlockingQueue creates a queue with the specified capacity.
+// If the capacity is not positive, then the queue is unbounded.
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	return &BlockingQueue[T]{capacity: capacity}
+}
+
+// BlockingQueue is a concurrency-safe FIFO queue with an optional capacity that blocks producers when it is full and consumers when it is empty.
+// The zero value is an unbounded queue.
+type BlockingQueue[T any] struct {
+	mu       sync.Mutex
+	elements []T
+	capacity int
+	closed   bool
+	changed  chan struct{}
+}
+
+var _ fmt.Stringer = (*BlockingQueue[any])(nil)
+
+// Put adds the element to the tail of the queue waiting for a free space if necessary.
+// Returns the context error if the context is done before the element is added, or ErrClosed if the queue is closed.
+func (q *BlockingQueue[T]) Put(ctx context.Context, element T) error {
+	for {
+		q.mu.Lock()
+		if q.closed {
+			q.mu.Unlock()
+			return ErrClosed
+		} else if !q.full() {
+			q.elements = append(q.elements, element)
+			q.notify()
+			q.mu.Unlock()
+			return nil
+		}
+		changed := q.wait()
+		q.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// Take retrieves and removes the head of the queue waiting for an element if necessary.
+// Returns the context error if the context is done before an element is available, or ErrClosed if the queue is closed and empty.
+func (q *BlockingQueue[T]) Take(ctx context.Context) (element T, err error) {
+	for {
+		q.mu.Lock()
+		if len(q.elements) > 0 {
+			element = q.pop()
+			q.mu.Unlock()
+			return element, nil
+		} else if q.closed {
+			q.mu.Unlock()
+			return element, ErrClosed
+		}
+		changed := q.wait()
+		q.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return element, ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// Offer adds the element to the tail of the queue if there is a free space and the queue is not closed.
+// Returns false otherwise.
+func (q *BlockingQueue[T]) Offer(element T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed || q.full() {
+		return false
+	}
+	q.elements = append(q.elements, element)
+	q.notify()
+	return true
+}
+
+// Poll retrieves and removes the head of the queue if the queue is not empty.
+func (q *BlockingQueue[T]) Poll() (element T, ok bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.elements) == 0 {
+		return element, false
+	}
+	return q.pop(), true
+}
+
+// Peek retrieves the head of the queue without removing it
+func (q *BlockingQueue[T]) Peek() (element T, ok bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return slice.Head(q.elements)
+}
+
+// DrainTo removes up to 'max' elements from the queue and adds them to the vector.
+// If 'max' is not positive, then all available elements are removed.
+// Returns the amount of the removed elements. Nothing is removed if the vector is nil.
+func (q *BlockingQueue[T]) DrainTo(out *mutable.Vector[T], max int) int {
+	if out == nil {
+		return 0
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	n := len(q.elements)
+	if max > 0 && max < n {
+		n = max
+	}
+	if n == 0 {
+		return 0
+	}
+	out.Add(q.elements[:n]...)
+	clear(q.elements[:n])
+	q.elements = q.elements[n:]
+	q.notify()
+	return n
+}
+
+// All is used to iterate through the elements using `for e := range`.
+// Every yielded element is removed from the queue. The iteration waits for new elements and ends when the queue is closed and drained.
+func (q *BlockingQueue[T]) All(consumer func(T) bool) {
+	for {
+		element, err := q.Take(context.Background())
+		if err != nil || !consumer(element) {
+			return
+		}
+	}
+}
+
+// Close closes the queue. The producers get the ErrClosed error, the consumers can take the remaining elements.
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.closed {
+		q.closed = true
+		q.notify()
+	}
+}
+
+// IsClosed returns true if the queue is closed
+func (q *BlockingQueue[T]) IsClosed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.closed
+}
+
+// Len returns amount of elements
+func (q *BlockingQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.elements)
+}
+
+// IsEmpty returns true if the queue is empty
+func (q *BlockingQueue[T]) IsEmpty() bool {
+	return q.Len() == 0
+}
+
+// Cap returns the capacity of the queue. Zero means that the queue is unbounded.
+func (q *BlockingQueue[T]) Cap() int {
+	return max(q.capacity, 0)
+}
+
+func (q *BlockingQueue[T]) String() string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return slice.ToString(q.elements)
+}
+
+func (q *BlockingQueue[T]) full() bool {
+	return q.capacity > 0 && len(q.elements) >= q.capacity
+}
+
+func (q *BlockingQueue[T]) pop() T {
+	element := q.elements[0]
+	var zero T
+	q.elements[0] = zero
+	q.elements = q.elements[1:]
+	q.notify()
+	return element
+}
+
+// wait returns a channel that is closed on the next change of the queue
+func (q *BlockingQueue[T]) wait() chan struct{} {
+	if q.changed == nil {
+		q.changed = make(chan struct{})
+	}
+	return q.changed
+}
+
+// notify wakes up all waiters
+func (q *BlockingQueue[T]) notify() {
+	if q.changed != nil {
+		close(q.changed)
+		q.changed = nil
+	}
+}
@@ -0,0 +1,249 @@
+package sync
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/collection/mutable"
+	"github.com/m4gshm/gollections/collection/mutable/ordered"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/slice"
+)
+
+// NewSet instantiates a concurrency-safe set and copies elements to it
+func NewSet[T comparable](elements ...T) *Set[T] {
+	return &Set[T]{elements: *mutable.NewSet(elements...)}
+}
+
+// Set is a concurrency-safe wrapper of the mutable.Set guarded by a RWMutex.
+// The methods that call user functions work with a snapshot of the set, so the lock is not held while the functions run.
+type Set[T comparable] struct {
+	mu       sync.RWMutex
+	elements mutable.Set[T]
+}
+
+var (
+	_ c.Addable[int]                = (*Set[int])(nil)
+	_ c.AddableNew[int]             = (*Set[int])(nil)
+	_ c.AddableAll[seq.Seq[int]]    = (*Set[int])(nil)
+	_ c.AddableAllNew[seq.Seq[int]] = (*Set[int])(nil)
+	_ c.Deleteable[int]             = (*Set[int])(nil)
+	_ c.DeleteableVerify[int]       = (*Set[int])(nil)
+	_ collection.Set[int]           = (*Set[int])(nil)
+	_ fmt.Stringer                  = (*Set[int])(nil)
+	_ json.Marshaler                = (*Set[int])(nil)
+	_ json.Unmarshaler              = (*Set[int])(nil)
+)
+
+// Update calls the 'update' function with the wrapped set under the write lock.
+// It is used for multi-step atomic modifications. The set must not be retained after the function returns.
+func (s *Set[T]) Update(update func(*mutable.Set[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	update(&s.elements)
+}
+
+// All is used to iterate through a snapshot of the collection using `for e := range`.
+func (s *Set[T]) All(consumer func(T) bool) {
+	s.snapshot().All(consumer)
+}
+
+// Head returns the first element.
+func (s *Set[T]) Head() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.elements.Head()
+}
+
+// Slice collects the elements to a slice
+func (s *Set[T]) Slice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.elements.Slice()
+}
+
+// Append collects the values to the specified 'out' slice
+func (s *Set[T]) Append(out []T) []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.elements.Append(out)
+}
+
+// Clone returns copy of the collection
+func (s *Set[T]) Clone() *Set[T] {
+	return &Set[T]{elements: *s.snapshot()}
+}
+
+// IsEmpty returns true if the collection is empty
+func (s *Set[T]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// Len returns amount of the elements
+func (s *Set[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.elements.Len()
+}
+
+// Contains checks if the collection contains an element
+func (s *Set[T]) Contains(element T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.elements.Contains(element)
+}
+
+// Add adds elements in the collection
+func (s *Set[T]) Add(elements ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.elements.Add(elements...)
+}
+
+// AddOne adds an element in the collection
+func (s *Set[T]) AddOne(element T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.elements.AddOne(element)
+}
+
+// AddNew inserts elements if they are not contained in the collection
+func (s *Set[T]) AddNew(elements ...T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.elements.AddNew(elements...)
+}
+
+// AddOneNew inserts an element if it is not contained in the collection
+func (s *Set[T]) AddOneNew(element T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.elements.AddOneNew(element)
+}
+
+// AddAll inserts all elements from the "other" seq. The seq is collected before locking.
+func (s *Set[T]) AddAll(other seq.Seq[T]) {
+	if other != nil {
+		s.Add(other.Slice()...)
+	}
+}
+
+// AddAllNew inserts elements from the "other" seq if they are not contained in the collection.
+// The seq is collected before locking.
+func (s *Set[T]) AddAllNew(other seq.Seq[T]) bool {
+	return other != nil && s.AddNew(other.Slice()...)
+}
+
+// Delete removes elements from the collection
+func (s *Set[T]) Delete(elements ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.elements.Delete(elements...)
+}
+
+// DeleteOne removes an element from the collection
+func (s *Set[T]) DeleteOne(element T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.elements.DeleteOne(element)
+}
+
+// DeleteActual removes elements only if they are contained in the collection
+func (s *Set[T]) DeleteActual(elements ...T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.elements.DeleteActual(elements...)
+}
+
+// DeleteActualOne removes an element only if it is contained in the collection
+func (s *Set[T]) DeleteActualOne(element T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.elements.DeleteActualOne(element)
+}
+
+// ForEach applies the 'consumer' function for every element of a snapshot
+func (s *Set[T]) ForEach(consumer func(T)) {
+	s.snapshot().ForEach(consumer)
+}
+
+// Filter returns a seq consisting of elements of a snapshot that satisfy the condition of the 'filter' function
+func (s *Set[T]) Filter(filter func(T) bool) seq.Seq[T] {
+	return collection.Filter(s, filter)
+}
+
+// Filt returns an errorable seq consisting of elements of a snapshot that satisfy the condition of the 'filter' function
+func (s *Set[T]) Filt(filter func(T) (bool, error)) seq.SeqE[T] {
+	return collection.Filt(s, filter)
+}
+
+// Convert returns a seq that applies the 'converter' function to the elements of a snapshot
+func (s *Set[T]) Convert(converter func(T) T) seq.Seq[T] {
+	return collection.Convert(s, converter)
+}
+
+// Conv returns an errorable seq that applies the 'converter' function to the elements of a snapshot
+func (s *Set[T]) Conv(converter func(T) (T, error)) seq.SeqE[T] {
+	return collection.Conv(s, converter)
+}
+
+// Reduce reduces the elements of a snapshot into an one using the 'merge' function
+func (s *Set[T]) Reduce(merge func(T, T) T) T {
+	return s.snapshot().Reduce(merge)
+}
+
+// HasAny checks whether a snapshot contains an element that satisfies the condition.
+func (s *Set[T]) HasAny(condition func(T) bool) bool {
+	return s.snapshot().HasAny(condition)
+}
+
+// First returns the element of a snapshot that satisfies the condition.
+func (s *Set[T]) First(condition func(T) bool) (T, bool) {
+	return s.snapshot().First(condition)
+}
+
+// Sort transforms a snapshot to the ordered Set contains sorted elements
+func (s *Set[T]) Sort(comparer slice.Comparer[T]) *ordered.Set[T] {
+	return s.snapshot().Sort(comparer)
+}
+
+// StableSort transforms a snapshot to the ordered Set contains sorted elements
+func (s *Set[T]) StableSort(comparer slice.Comparer[T]) *ordered.Set[T] {
+	return s.snapshot().StableSort(comparer)
+}
+
+func (s *Set[T]) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.elements.String()
+}
+
+func (s *Set[T]) snapshot() *mutable.Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.elements.Clone()
+}
+
+// MarshalJSON encodes a snapshot of the set as a JSON array
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalArray(s.snapshot().All)
+}
+
+// UnmarshalJSON replaces the set elements by the elements of a JSON array under the write lock
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	var elements mutable.Set[T]
+	if err := elements.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.elements = elements
+	return nil
+}
@@ -0,0 +1,398 @@
+package sync
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/maphash"
+	"maps"
+	"runtime"
+	"sync"
+
+	converte "github.com/m4gshm/gollections/break/kv/convert"
+	filtere "github.com/m4gshm/gollections/break/kv/predicate"
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/kv/convert"
+	kvfilter "github.com/m4gshm/gollections/kv/predicate"
+	"github.com/m4gshm/gollections/map_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/seq2"
+)
+
+// Hasher calculates a hash of a key that is used to select a shard
+type Hasher[K any] func(K) uint64
+
+// ComparableHasher returns a hasher of comparable keys based on the hash/maphash package with a random seed
+func ComparableHasher[K comparable]() Hasher[K] {
+	seed := maphash.MakeSeed()
+	return func(key K) uint64 { return maphash.Comparable(seed, key) }
+}
+
+// NewShardedMap creates a sharded map with the specified amount of shards and the comparable keys hasher.
+// If the amount is not positive, then it is calculated based on the GOMAXPROCS value.
+func NewShardedMap[K comparable, V any](shards int) *ShardedMap[K, V] {
+	return NewShardedMapHasher[K, V](shards, ComparableHasher[K]())
+}
+
+// NewShardedMapHasher creates a sharded map with the specified amount of shards and the 'hasher' function.
+// If the amount is not positive, then it is calculated based on the GOMAXPROCS value.
+// If the hasher is nil, then the ComparableHasher is used.
+func NewShardedMapHasher[K comparable, V any](shards int, hasher Hasher[K]) *ShardedMap[K, V] {
+	m := &ShardedMap[K, V]{}
+	m.init(shards, hasher)
+	return m
+}
+
+// ShardedMap is a concurrent map that partitions keys across several shards, each guarded by its own RWMutex.
+// The zero value is an empty map with the default amount of shards and the ComparableHasher.
+type ShardedMap[K comparable, V any] struct {
+	once   sync.Once
+	shards []*shard[K, V]
+	hasher Hasher[K]
+}
+
+type shard[K comparable, V any] struct {
+	mu       sync.RWMutex
+	elements map[K]V
+}
+
+var (
+	_ c.Settable[int, any]                 = (*ShardedMap[int, any])(nil)
+	_ c.SettableNew[int, any]              = (*ShardedMap[int, any])(nil)
+	_ c.SettableMap[c.TrackEach[int, any]] = (*ShardedMap[int, any])(nil)
+	_ c.Deleteable[int]                    = (*ShardedMap[int, any])(nil)
+	_ c.Removable[int, any]                = (*ShardedMap[int, any])(nil)
+	_ c.Access[int, any]                   = (*ShardedMap[int, any])(nil)
+	_ collection.Map[int, any]             = (*ShardedMap[int, any])(nil)
+	_ fmt.Stringer                         = (*ShardedMap[int, any])(nil)
+	_ json.Marshaler                       = (*ShardedMap[int, any])(nil)
+	_ json.Unmarshaler                     = (*ShardedMap[int, any])(nil)
+)
+
+func (m *ShardedMap[K, V]) init(shards int, hasher Hasher[K]) {
+	if shards <= 0 {
+		shards = runtime.GOMAXPROCS(0) * 4
+	}
+	if hasher == nil {
+		hasher = ComparableHasher[K]()
+	}
+	m.shards, m.hasher = make([]*shard[K, V], shards), hasher
+	for i := range m.shards {
+		m.shards[i] = &shard[K, V]{elements: map[K]V{}}
+	}
+}
+
+// storage returns the shards, the zero value map is initialized on the first call
+func (m *ShardedMap[K, V]) storage() []*shard[K, V] {
+	m.once.Do(func() {
+		if m.shards == nil {
+			m.init(0, nil)
+		}
+	})
+	return m.shards
+}
+
+func (m *ShardedMap[K, V]) index(key K) uint64 {
+	return m.hasher(key) % uint64(len(m.storage()))
+}
+
+func (m *ShardedMap[K, V]) shard(key K) *shard[K, V] {
+	return m.storage()[m.index(key)]
+}
+
+// All is used to iterate through the collection using `for key, val := range`.
+// Every shard is iterated over its snapshot, so the consumer may modify the map.
+func (m *ShardedMap[K, V]) All(consumer func(K, V) bool) {
+	for _, s := range m.storage() {
+		s.mu.RLock()
+		keys, values := make([]K, 0, len(s.elements)), make([]V, 0, len(s.elements))
+		for key, value := range s.elements {
+			keys, values = append(keys, key), append(values, value)
+		}
+		s.mu.RUnlock()
+		for i, key := range keys {
+			if !consumer(key, values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns a seq of the map keys
+func (m *ShardedMap[K, V]) Keys() seq.Seq[K] {
+	return seq2.Keys(m.All)
+}
+
+// Values returns a seq of the map values
+func (m *ShardedMap[K, V]) Values() seq.Seq[V] {
+	return seq2.Values(m.All)
+}
+
+// Head returns the first key\value pair.
+func (m *ShardedMap[K, V]) Head() (K, V, bool) {
+	return seq2.Head(m.All)
+}
+
+// Map collects the key/value pairs into a new map
+func (m *ShardedMap[K, V]) Map() map[K]V {
+	return seq2.Map(m.All)
+}
+
+// Len returns amount of elements
+func (m *ShardedMap[K, V]) Len() (count int) {
+	for _, s := range m.storage() {
+		s.mu.RLock()
+		count += len(s.elements)
+		s.mu.RUnlock()
+	}
+	return count
+}
+
+// IsEmpty returns true if the map is empty
+func (m *ShardedMap[K, V]) IsEmpty() bool {
+	return collection.IsEmpty(m)
+}
+
+// TrackEach applies the 'consumer' function for every key/value pairs
+func (m *ShardedMap[K, V]) TrackEach(consumer func(K, V)) {
+	for key, value := range m.All {
+		consumer(key, value)
+	}
+}
+
+// Contains checks is the map contains a key
+func (m *ShardedMap[K, V]) Contains(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Get returns the value for a key.
+// If ok==false, then the map does not contain the key.
+func (m *ShardedMap[K, V]) Get(key K) (V, bool) {
+	s := m.shard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, ok := s.elements[key]
+	return value, ok
+}
+
+// Set sets the value for a key
+func (m *ShardedMap[K, V]) Set(key K, value V) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.elements[key] = value
+}
+
+// SetNew sets the value for a key only if the key is not exists in the map
+func (m *ShardedMap[K, V]) SetNew(key K, value V) bool {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.elements[key]; ok {
+		return false
+	}
+	s.elements[key] = value
+	return true
+}
+
+// SetMap inserts all elements from the 'other' map. Every shard is locked once.
+func (m *ShardedMap[K, V]) SetMap(other c.TrackEach[K, V]) {
+	if other == nil {
+		return
+	}
+	shards := m.storage()
+	parts := make([]map[K]V, len(shards))
+	other.TrackEach(func(key K, value V) {
+		i := m.index(key)
+		if parts[i] == nil {
+			parts[i] = map[K]V{}
+		}
+		parts[i][key] = value
+	})
+	for i, part := range parts {
+		if part != nil {
+			s := shards[i]
+			s.mu.Lock()
+			maps.Copy(s.elements, part)
+			s.mu.Unlock()
+		}
+	}
+}
+
+// Compute atomically calculates a new value for a key using the 'remapping' function.
+// The function receives the current value and the presence flag, and returns the new value and the presence flag.
+// If the returned flag is false, then the key is deleted.
+func (m *ShardedMap[K, V]) Compute(key K, remapping func(value V, ok bool) (V, bool)) (V, bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, exists := s.elements[key]
+	value, ok := remapping(old, exists)
+	if ok {
+		s.elements[key] = value
+	} else if exists {
+		delete(s.elements, key)
+	}
+	return value, ok
+}
+
+// Upsert atomically sets the value for a key if the key is absent, or the result of the 'merge' function of the old and the new values otherwise.
+// Returns the stored value.
+func (m *ShardedMap[K, V]) Upsert(key K, value V, merge func(old, value V) V) V {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if old, ok := s.elements[key]; ok {
+		value = merge(old, value)
+	}
+	s.elements[key] = value
+	return value
+}
+
+// Delete removes value by their keys from the map
+func (m *ShardedMap[K, V]) Delete(keys ...K) {
+	for _, key := range keys {
+		m.DeleteOne(key)
+	}
+}
+
+// DeleteOne removes a value by the key from the map
+func (m *ShardedMap[K, V]) DeleteOne(key K) {
+	_, _ = m.Remove(key)
+}
+
+// Remove removes value by key and return it
+func (m *ShardedMap[K, V]) Remove(key K) (V, bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.elements[key]
+	if ok {
+		delete(s.elements, key)
+	}
+	return value, ok
+}
+
+// Clear deletes all the entries
+func (m *ShardedMap[K, V]) Clear() {
+	for _, s := range m.storage() {
+		s.mu.Lock()
+		clear(s.elements)
+		s.mu.Unlock()
+	}
+}
+
+// String string representation on the map
+func (m *ShardedMap[K, V]) String() string {
+	return map_.ToString(m.Map())
+}
+
+// FilterKey returns a seq consisting of key/value pairs where the key satisfies the condition of the 'filter' function
+func (m *ShardedMap[K, V]) FilterKey(filter func(K) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, kvfilter.Key[V](filter))
+}
+
+// FiltKey returns an errorable seq consisting of key/value pairs where the key satisfies the condition of the 'filter' function
+func (m *ShardedMap[K, V]) FiltKey(filter func(K) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, filtere.Key[V](filter))
+}
+
+// ConvertKey returns a seq that applies the 'converter' function to keys of the map
+func (m *ShardedMap[K, V]) ConvertKey(converter func(K) K) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, convert.Key[V](converter))
+}
+
+// ConvKey returns an errorable seq that applies the 'converter' function to keys of the map
+func (m *ShardedMap[K, V]) ConvKey(converter func(K) (K, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converte.Key[V](converter))
+}
+
+// FilterValue returns a seq consisting of key/value pairs where the value satisfies the condition of the 'filter' function
+func (m *ShardedMap[K, V]) FilterValue(filter func(V) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, kvfilter.Value[K](filter))
+}
+
+// FiltValue returns an errorable seq consisting of key/value pairs where the value satisfies the condition of the 'filter' function
+func (m *ShardedMap[K, V]) FiltValue(filter func(V) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, filtere.Value[K](filter))
+}
+
+// ConvertValue returns a seq that applies the 'converter' function to values of the map
+func (m *ShardedMap[K, V]) ConvertValue(converter func(V) V) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, convert.Value[K](converter))
+}
+
+// ConvValue returns an errorable seq that applies the 'converter' function to values of the map
+func (m *ShardedMap[K, V]) ConvValue(converter func(V) (V, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converte.Value[K](converter))
+}
+
+// Filter returns a seq consisting of elements that satisfy the condition of the 'filter' function
+func (m *ShardedMap[K, V]) Filter(filter func(K, V) bool) seq.Seq2[K, V] {
+	return seq2.Filter(m.All, filter)
+}
+
+// Filt returns an errorable seq consisting of elements that satisfy the condition of the 'filter' function
+func (m *ShardedMap[K, V]) Filt(filter func(K, V) (bool, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Filt(m.All, filter)
+}
+
+// Convert returns a seq that applies the 'converter' function to the collection elements
+func (m *ShardedMap[K, V]) Convert(converter func(K, V) (K, V)) seq.Seq2[K, V] {
+	return seq2.Convert(m.All, converter)
+}
+
+// Conv returns an errorable seq that applies the 'converter' function to the collection elements
+func (m *ShardedMap[K, V]) Conv(converter func(K, V) (K, V, error)) seq.SeqE[c.KV[K, V]] {
+	return seq2.Conv(m.All, converter)
+}
+
+// Reduce reduces the key/value pairs of the map into an one pair using the 'merge' function
+func (m *ShardedMap[K, V]) Reduce(merge func(K, K, V, V) (K, V)) (rk K, rv V) {
+	first := true
+	for key, value := range m.All {
+		if first {
+			rk, rv = key, value
+			first = false
+		} else {
+			rk, rv = merge(rk, key, rv, value)
+		}
+	}
+	return rk, rv
+}
+
+// HasAny checks whether the map contains a key\value pair that satisfies the condition.
+func (m *ShardedMap[K, V]) HasAny(condition func(K, V) bool) bool {
+	return seq2.HasAny(m.All, condition)
+}
+
+// MarshalJSON encodes the map as a JSON object sorted by the keys. Every shard is encoded from its snapshot.
+func (m *ShardedMap[K, V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalSortedObject(m.All)
+}
+
+// UnmarshalJSON replaces the map entries by the members of a JSON object under the write locks of all the shards
+func (m *ShardedMap[K, V]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	pairs, err := json_.UnmarshalPairs[K, V](data)
+	if err != nil {
+		return err
+	}
+	m.replace(pairs)
+	return nil
+}
+
+func (m *ShardedMap[K, V]) replace(pairs []c.KV[K, V]) {
+	for _, s := range m.storage() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		clear(s.elements)
+	}
+	for _, kv := range pairs {
+		m.shard(kv.K).elements[kv.K] = kv.V
+	}
+}
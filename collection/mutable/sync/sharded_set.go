@@ -0,0 +1,206 @@
+package sync
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/slice"
+)
+
+// NewShardedSet creates a sharded set with the specified amount of shards and the comparable elements hasher.
+// If the amount is not positive, then it is calculated based on the GOMAXPROCS value.
+func NewShardedSet[T comparable](shards int) *ShardedSet[T] {
+	return NewShardedSetHasher(shards, ComparableHasher[T]())
+}
+
+// NewShardedSetHasher creates a sharded set with the specified amount of shards and the 'hasher' function.
+// If the amount is not positive, then it is calculated based on the GOMAXPROCS value.
+// If the hasher is nil, then the ComparableHasher is used.
+func NewShardedSetHasher[T comparable](shards int, hasher Hasher[T]) *ShardedSet[T] {
+	s := &ShardedSet[T]{}
+	s.elements.init(shards, hasher)
+	return s
+}
+
+// ShardedSet is a concurrent set that partitions elements across several shards, each guarded by its own RWMutex.
+// The zero value is an empty set with the default amount of shards and the ComparableHasher.
+type ShardedSet[T comparable] struct {
+	elements ShardedMap[T, struct{}]
+}
+
+var (
+	_ c.Addable[int]          = (*ShardedSet[int])(nil)
+	_ c.AddableNew[int]       = (*ShardedSet[int])(nil)
+	_ c.Deleteable[int]       = (*ShardedSet[int])(nil)
+	_ c.DeleteableVerify[int] = (*ShardedSet[int])(nil)
+	_ collection.Set[int]     = (*ShardedSet[int])(nil)
+	_ fmt.Stringer            = (*ShardedSet[int])(nil)
+	_ json.Marshaler          = (*ShardedSet[int])(nil)
+	_ json.Unmarshaler        = (*ShardedSet[int])(nil)
+)
+
+// All is used to iterate through the collection using `for e := range`.
+// Every shard is iterated over its snapshot, so the consumer may modify the set.
+func (s *ShardedSet[T]) All(consumer func(T) bool) {
+	s.elements.All(func(e T, _ struct{}) bool { return consumer(e) })
+}
+
+// Head returns the first element.
+func (s *ShardedSet[T]) Head() (T, bool) {
+	return collection.Head(s)
+}
+
+// Slice collects the elements to a slice
+func (s *ShardedSet[T]) Slice() []T {
+	return seq.Slice(s.All)
+}
+
+// Append collects the values to the specified 'out' slice
+func (s *ShardedSet[T]) Append(out []T) []T {
+	for e := range s.All {
+		out = append(out, e)
+	}
+	return out
+}
+
+// Len returns amount of elements
+func (s *ShardedSet[T]) Len() int {
+	return s.elements.Len()
+}
+
+// IsEmpty returns true if the collection is empty
+func (s *ShardedSet[T]) IsEmpty() bool {
+	return collection.IsEmpty(s)
+}
+
+// Contains checks is the collection contains an element
+func (s *ShardedSet[T]) Contains(element T) bool {
+	return s.elements.Contains(element)
+}
+
+// Add adds elements in the collection
+func (s *ShardedSet[T]) Add(elements ...T) {
+	for _, element := range elements {
+		s.AddOne(element)
+	}
+}
+
+// AddOne adds an element in the collection
+func (s *ShardedSet[T]) AddOne(element T) {
+	s.elements.Set(element, struct{}{})
+}
+
+// AddNew inserts elements if they are not contained in the collection
+func (s *ShardedSet[T]) AddNew(elements ...T) (ok bool) {
+	for _, element := range elements {
+		ok = s.AddOneNew(element) || ok
+	}
+	return ok
+}
+
+// AddOneNew inserts an element if it is not contained in the collection
+func (s *ShardedSet[T]) AddOneNew(element T) bool {
+	return s.elements.SetNew(element, struct{}{})
+}
+
+// Delete removes elements from the collection
+func (s *ShardedSet[T]) Delete(elements ...T) {
+	s.elements.Delete(elements...)
+}
+
+// DeleteOne removes an element from the collection
+func (s *ShardedSet[T]) DeleteOne(element T) {
+	s.elements.DeleteOne(element)
+}
+
+// DeleteActual removes elements only if they are contained in the collection
+func (s *ShardedSet[T]) DeleteActual(elements ...T) (ok bool) {
+	for _, element := range elements {
+		ok = s.DeleteActualOne(element) || ok
+	}
+	return ok
+}
+
+// DeleteActualOne removes an element only if it is contained in the collection
+func (s *ShardedSet[T]) DeleteActualOne(element T) bool {
+	_, ok := s.elements.Remove(element)
+	return ok
+}
+
+// Clear deletes all the elements
+func (s *ShardedSet[T]) Clear() {
+	s.elements.Clear()
+}
+
+// ForEach applies the 'consumer' function for every element
+func (s *ShardedSet[T]) ForEach(consumer func(T)) {
+	for e := range s.All {
+		consumer(e)
+	}
+}
+
+// Filter returns a seq consisting of elements that satisfy the condition of the 'filter' function
+func (s *ShardedSet[T]) Filter(filter func(T) bool) seq.Seq[T] {
+	return collection.Filter(s, filter)
+}
+
+// Filt returns an errorable seq consisting of elements that satisfy the condition of the 'filter' function
+func (s *ShardedSet[T]) Filt(filter func(T) (bool, error)) seq.SeqE[T] {
+	return collection.Filt(s, filter)
+}
+
+// Convert returns a seq that applies the 'converter' function to the collection elements
+func (s *ShardedSet[T]) Convert(converter func(T) T) seq.Seq[T] {
+	return collection.Convert(s, converter)
+}
+
+// Conv returns an errorable seq that applies the 'converter' function to the collection elements
+func (s *ShardedSet[T]) Conv(converter func(T) (T, error)) seq.SeqE[T] {
+	return collection.Conv(s, converter)
+}
+
+// Reduce reduces the elements into an one using the 'merge' function
+func (s *ShardedSet[T]) Reduce(merge func(T, T) T) T {
+	return collection.Reduce(s, merge)
+}
+
+// HasAny checks whether the set contains an element that satisfies the condition.
+func (s *ShardedSet[T]) HasAny(condition func(T) bool) bool {
+	_, ok := s.First(condition)
+	return ok
+}
+
+// First returns the first element that satisfies the condition.
+func (s *ShardedSet[T]) First(condition func(T) bool) (T, bool) {
+	return collection.First(s, condition)
+}
+
+func (s *ShardedSet[T]) String() string {
+	return slice.ToString(s.Slice())
+}
+
+// MarshalJSON encodes the set as a JSON array. Every shard is encoded from its snapshot.
+func (s *ShardedSet[T]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalArray(s.All)
+}
+
+// UnmarshalJSON replaces the set elements by the elements of a JSON array under the write locks of all the shards
+func (s *ShardedSet[T]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	elements, err := json_.UnmarshalArray[T](data)
+	if err != nil {
+		return err
+	}
+	pairs := make([]c.KV[T, struct{}], len(elements))
+	for i, e := range elements {
+		pairs[i] = c.KV[T, struct{}]{K: e}
+	}
+	s.elements.replace(pairs)
+	return nil
+}
@@ -0,0 +1,91 @@
+package test
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	syncmap "github.com/m4gshm/gollections/collection/mutable/sync"
+	"github.com/m4gshm/gollections/k"
+)
+
+func marshal(t *testing.T, value any) string {
+	data, err := json.Marshal(value)
+	assert.NoError(t, err)
+	return string(data)
+}
+
+func Test_JSON_Map(t *testing.T) {
+	var m syncmap.Map[int, string]
+	m.Set(2, "b")
+	m.Set(1, "a")
+	assert.Equal(t, `{"1":"a","2":"b"}`, marshal(t, &m))
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"3":"c"}`), &m))
+	assert.Equal(t, map[int]string{3: "c"}, m.Map())
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &m))
+	assert.Equal(t, map[int]string{3: "c"}, m.Map())
+	assert.Error(t, json.Unmarshal([]byte(`{"x":"c"}`), &m))
+}
+
+func Test_JSON_OrderedMap(t *testing.T) {
+	m := syncmap.NewOrderedMap(k.V("z", 1), k.V("a", 2))
+	assert.Equal(t, `{"z":1,"a":2}`, marshal(t, m))
+
+	var decoded syncmap.OrderedMap[string, int]
+	assert.NoError(t, json.Unmarshal([]byte(`{"y":1,"b":2,"x":3}`), &decoded))
+	assert.Equal(t, []string{"y", "b", "x"}, decoded.Keys().Slice())
+	assert.Equal(t, `{"y":1,"b":2,"x":3}`, marshal(t, &decoded))
+}
+
+func Test_JSON_SetVector(t *testing.T) {
+	assert.Equal(t, `[3,1,2]`, marshal(t, syncmap.NewVector(3, 1, 2)))
+	assert.Equal(t, `[]`, marshal(t, syncmap.NewVector[int]()))
+	assert.Equal(t, `[2]`, marshal(t, syncmap.NewSet(2)))
+
+	var v syncmap.Vector[int]
+	assert.NoError(t, json.Unmarshal([]byte(`[1,2,1]`), &v))
+	assert.Equal(t, []int{1, 2, 1}, v.Slice())
+
+	var s syncmap.Set[string]
+	assert.NoError(t, json.Unmarshal([]byte(`["a","b","a"]`), &s))
+	elements := s.Slice()
+	sort.Strings(elements)
+	assert.Equal(t, []string{"a", "b"}, elements)
+	assert.Error(t, json.Unmarshal([]byte(`{"a":1}`), &s))
+}
+
+func Test_JSON_Sharded(t *testing.T) {
+	m := syncmap.NewShardedMap[string, int](4)
+	m.Set("b", 2)
+	m.Set("a", 1)
+	assert.Equal(t, `{"a":1,"b":2}`, marshal(t, m))
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"c":3,"d":4}`), m))
+	assert.Equal(t, map[string]int{"c": 3, "d": 4}, m.Map())
+
+	s := syncmap.NewShardedSet[int](4)
+	s.Add(3, 1)
+	elements := []int{}
+	assert.NoError(t, json.Unmarshal([]byte(marshal(t, s)), &elements))
+	sort.Ints(elements)
+	assert.Equal(t, []int{1, 3}, elements)
+
+	assert.NoError(t, json.Unmarshal([]byte(`[5,4,5]`), s))
+	elements = s.Slice()
+	sort.Ints(elements)
+	assert.Equal(t, []int{4, 5}, elements)
+}
+
+func Test_JSON_Sharded_Zero(t *testing.T) {
+	var m syncmap.ShardedMap[string, int]
+	assert.NoError(t, json.Unmarshal([]byte(`{"a":1}`), &m))
+	assert.Equal(t, map[string]int{"a": 1}, m.Map())
+
+	var s syncmap.ShardedSet[int]
+	assert.Equal(t, `[]`, marshal(t, &s))
+	assert.NoError(t, json.Unmarshal([]byte(`[1]`), &s))
+	assert.True(t, s.Contains(1))
+}
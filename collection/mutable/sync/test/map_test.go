@@ -0,0 +1,102 @@
+package test
+
+import (
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	syncmap "github.com/m4gshm/gollections/collection/mutable/sync"
+	"github.com/m4gshm/gollections/slice"
+)
+
+func Test_Map_MissingKey(t *testing.T) {
+	m := syncmap.NewMap[int, string]()
+
+	v, ok := m.Get(1)
+	assert.False(t, ok)
+	assert.Equal(t, "", v)
+
+	v, ok = m.Remove(1)
+	assert.False(t, ok)
+	assert.Equal(t, "", v)
+}
+
+func Test_Map_NilInterfaceValue(t *testing.T) {
+	m := syncmap.NewMap[int, any]()
+	m.Set(1, nil)
+
+	v, ok := m.Get(1)
+	assert.True(t, ok)
+	assert.Nil(t, v)
+}
+
+func Test_Map_Atomics(t *testing.T) {
+	m := syncmap.NewMap[string, int]()
+
+	actual, loaded := m.LoadOrStore("a", 1)
+	assert.False(t, loaded)
+	assert.Equal(t, 1, actual)
+	actual, loaded = m.LoadOrStore("a", 2)
+	assert.True(t, loaded)
+	assert.Equal(t, 1, actual)
+
+	previous, loaded := m.Swap("a", 3)
+	assert.True(t, loaded)
+	assert.Equal(t, 1, previous)
+
+	assert.False(t, syncmap.CompareAndSwap(&m, "a", 1, 4))
+	assert.True(t, syncmap.CompareAndSwap(&m, "a", 3, 4))
+	assert.False(t, syncmap.CompareAndDelete(&m, "a", 3))
+	assert.True(t, syncmap.CompareAndDelete(&m, "a", 4))
+	assert.False(t, m.Contains("a"))
+
+	m.Set("b", 5)
+	v, loaded := m.LoadAndDelete("b")
+	assert.True(t, loaded)
+	assert.Equal(t, 5, v)
+}
+
+func Test_Map_Iterate(t *testing.T) {
+	m := syncmap.NewMap[int, string]()
+	m.Set(1, "1")
+	m.Set(2, "2")
+	m.Set(3, "3")
+
+	assert.Equal(t, 3, m.Len())
+	assert.Equal(t, map[int]string{1: "1", 2: "2", 3: "3"}, m.Map())
+
+	keys := m.Keys().Slice()
+	sort.Ints(keys)
+	assert.Equal(t, slice.Of(1, 2, 3), keys)
+
+	values := m.Values().Slice()
+	sort.Strings(values)
+	assert.Equal(t, slice.Of("1", "2", "3"), values)
+
+	count := 0
+	for range m.All {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count)
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+}
+
+func Test_Map_Concurrent(t *testing.T) {
+	m := syncmap.NewMap[int, int]()
+	var wg sync.WaitGroup
+	for i := range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.Set(i, i)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 100, m.Len())
+}
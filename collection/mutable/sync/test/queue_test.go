@@ -0,0 +1,112 @@
+package test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection/mutable"
+	syncmap "github.com/m4gshm/gollections/collection/mutable/sync"
+	"github.com/m4gshm/gollections/slice"
+)
+
+func Test_BlockingQueue_OfferPoll(t *testing.T) {
+	q := syncmap.NewBlockingQueue[int](2)
+
+	assert.True(t, q.Offer(1))
+	assert.True(t, q.Offer(2))
+	assert.False(t, q.Offer(3))
+
+	head, ok := q.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, 1, head)
+
+	e, ok := q.Poll()
+	assert.True(t, ok)
+	assert.Equal(t, 1, e)
+	assert.Equal(t, 1, q.Len())
+}
+
+func Test_BlockingQueue_Timeout(t *testing.T) {
+	q := syncmap.NewBlockingQueue[int](1)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := q.Take(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.NoError(t, q.Put(context.Background(), 1))
+	assert.ErrorIs(t, q.Put(ctx, 2), context.DeadlineExceeded)
+}
+
+func Test_BlockingQueue_PutBlocksUntilTake(t *testing.T) {
+	q := syncmap.NewBlockingQueue[int](1)
+	assert.NoError(t, q.Put(context.Background(), 1))
+
+	done := make(chan error)
+	go func() { done <- q.Put(context.Background(), 2) }()
+
+	e, err := q.Take(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, e)
+	assert.NoError(t, <-done)
+	assert.Equal(t, 1, q.Len())
+}
+
+func Test_BlockingQueue_DrainTo(t *testing.T) {
+	q := syncmap.NewBlockingQueue[int](0)
+	for i := range 5 {
+		q.Offer(i)
+	}
+
+	assert.Equal(t, 0, q.DrainTo(nil, 0))
+	assert.Equal(t, 5, q.Len())
+
+	out := mutable.NewVector[int]()
+	assert.Equal(t, 3, q.DrainTo(out, 3))
+	assert.Equal(t, slice.Of(0, 1, 2), out.Slice())
+	assert.Equal(t, 2, q.DrainTo(out, 0))
+	assert.Equal(t, slice.Of(0, 1, 2, 3, 4), out.Slice())
+	assert.True(t, q.IsEmpty())
+}
+
+func Test_BlockingQueue_RangeUntilClosed(t *testing.T) {
+	q := syncmap.NewBlockingQueue[int](4)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := range 100 {
+			_ = q.Put(context.Background(), i)
+		}
+		q.Close()
+	}()
+
+	var received []int
+	for e := range q.All {
+		received = append(received, e)
+	}
+	wg.Wait()
+
+	assert.Len(t, received, 100)
+	assert.Equal(t, 99, received[99])
+	assert.ErrorIs(t, q.Put(context.Background(), 1), syncmap.ErrClosed)
+	assert.False(t, q.Offer(1))
+}
+
+func Test_BlockingQueue_CloseWakesConsumers(t *testing.T) {
+	var q syncmap.BlockingQueue[int]
+	done := make(chan error)
+	go func() {
+		_, err := q.Take(context.Background())
+		done <- err
+	}()
+	time.Sleep(5 * time.Millisecond)
+	q.Close()
+
+	assert.ErrorIs(t, <-done, syncmap.ErrClosed)
+}
@@ -0,0 +1,121 @@
+package test
+
+import (
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection/mutable"
+	syncmap "github.com/m4gshm/gollections/collection/mutable/sync"
+	"github.com/m4gshm/gollections/k"
+	"github.com/m4gshm/gollections/slice"
+)
+
+func Test_ShardedMap(t *testing.T) {
+	m := syncmap.NewShardedMap[string, int](4)
+	m.Set("a", 1)
+	assert.True(t, m.SetNew("b", 2))
+	assert.False(t, m.SetNew("b", 3))
+
+	v, ok := m.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+	assert.Equal(t, 2, m.Len())
+
+	v, ok = m.Remove("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.False(t, m.Contains("a"))
+}
+
+func Test_ShardedMap_Compute(t *testing.T) {
+	m := syncmap.NewShardedMap[string, int](4)
+
+	v, ok := m.Compute("a", func(v int, ok bool) (int, bool) { return v + 1, true })
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	_, ok = m.Compute("a", func(v int, ok bool) (int, bool) { return 0, false })
+	assert.False(t, ok)
+	assert.False(t, m.Contains("a"))
+
+	sum := func(old, value int) int { return old + value }
+	assert.Equal(t, 5, m.Upsert("b", 5, sum))
+	assert.Equal(t, 8, m.Upsert("b", 3, sum))
+}
+
+func Test_ShardedMap_ConcurrentCounters(t *testing.T) {
+	m := syncmap.NewShardedMap[int, int](0)
+	var wg sync.WaitGroup
+	for i := range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.Upsert(i%10, 1, func(old, value int) int { return old + value })
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 10, m.Len())
+	for _, count := range m.All {
+		assert.Equal(t, 10, count)
+	}
+}
+
+func Test_ShardedMap_SetMap(t *testing.T) {
+	m := syncmap.NewShardedMapHasher[int, string](3, func(key int) uint64 { return uint64(key) })
+	m.SetMap(mutable.NewMap(k.V(1, "1"), k.V(2, "2"), k.V(3, "3")))
+
+	assert.Equal(t, map[int]string{1: "1", 2: "2", 3: "3"}, m.Map())
+
+	for key := range m.All {
+		m.DeleteOne(key)
+	}
+	assert.True(t, m.IsEmpty())
+}
+
+func Test_ShardedSet(t *testing.T) {
+	s := syncmap.NewShardedSet[int](4)
+	s.Add(3, 1, 2, 1)
+
+	assert.Equal(t, 3, s.Len())
+	assert.False(t, s.AddOneNew(2))
+	assert.True(t, s.DeleteActualOne(2))
+
+	elements := s.Slice()
+	sort.Ints(elements)
+	assert.Equal(t, slice.Of(1, 3), elements)
+
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+}
+
+func Test_Sharded_Zero(t *testing.T) {
+	var m syncmap.ShardedMap[string, int]
+	assert.True(t, m.IsEmpty())
+	m.Set("a", 1)
+	m.SetMap(mutable.NewMap(k.V("b", 2)))
+	v, ok := m.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, 2, m.Len())
+
+	var s syncmap.ShardedSet[int]
+	assert.False(t, s.Contains(1))
+	s.Add(1, 2, 1)
+	assert.Equal(t, 2, s.Len())
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+}
+
+func Test_Sharded_NilHasher(t *testing.T) {
+	m := syncmap.NewShardedMapHasher[string, int](2, nil)
+	m.Set("a", 1)
+	assert.True(t, m.Contains("a"))
+
+	s := syncmap.NewShardedSetHasher[int](2, nil)
+	s.Add(1)
+	assert.True(t, s.Contains(1))
+}
@@ -0,0 +1,105 @@
+package test
+
+import (
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection/mutable"
+	"github.com/m4gshm/gollections/collection/mutable/ordered"
+	syncmap "github.com/m4gshm/gollections/collection/mutable/sync"
+	"github.com/m4gshm/gollections/k"
+	"github.com/m4gshm/gollections/slice"
+)
+
+func Test_Vector(t *testing.T) {
+	v := syncmap.NewVector(1, 2, 3)
+	v.Add(4)
+	v.Set(0, 10)
+
+	assert.Equal(t, slice.Of(10, 2, 3, 4), v.Slice())
+	e, ok := v.Remove(1)
+	assert.True(t, ok)
+	assert.Equal(t, 2, e)
+	assert.Equal(t, 3, v.Len())
+}
+
+func Test_Vector_Update(t *testing.T) {
+	v := syncmap.NewVector[int]()
+	var wg sync.WaitGroup
+	for i := range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v.Update(func(elements *mutable.Vector[int]) {
+				if !elements.HasAny(func(e int) bool { return e == i%10 }) {
+					elements.Add(i % 10)
+				}
+			})
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, slice.Of(0, 1, 2, 3, 4, 5, 6, 7, 8, 9), v.Sort(func(a, b int) int { return a - b }).Slice())
+}
+
+func Test_Vector_RangeDoesNotHoldLock(t *testing.T) {
+	v := syncmap.NewVector(1, 2, 3)
+	for e := range v.All {
+		v.Add(e * 10)
+	}
+	assert.Equal(t, slice.Of(1, 2, 3, 10, 20, 30), v.Slice())
+}
+
+func Test_Set(t *testing.T) {
+	s := syncmap.NewSet(1, 2)
+	assert.True(t, s.AddOneNew(3))
+	assert.False(t, s.AddOneNew(3))
+
+	for e := range s.All {
+		s.DeleteOne(e)
+	}
+	assert.True(t, s.IsEmpty())
+
+	var zero syncmap.Set[string]
+	zero.Add("a")
+	assert.True(t, zero.Contains("a"))
+}
+
+func Test_Set_Concurrent(t *testing.T) {
+	s := syncmap.NewSet[int]()
+	var wg sync.WaitGroup
+	for i := range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.Add(i)
+		}()
+	}
+	wg.Wait()
+
+	elements := s.Slice()
+	sort.Ints(elements)
+	assert.Equal(t, 100, len(elements))
+}
+
+func Test_OrderedMap(t *testing.T) {
+	m := syncmap.NewOrderedMap(k.V("b", 2), k.V("a", 1))
+	m.Set("c", 3)
+
+	assert.Equal(t, slice.Of("b", "a", "c"), m.Keys().Slice())
+	for key, value := range m.All {
+		m.Set(key+key, value)
+	}
+	assert.Equal(t, slice.Of("b", "a", "c", "bb", "aa", "cc"), m.Keys().Slice())
+
+	m.Update(func(elements *ordered.Map[string, int]) {
+		elements.Sort(func(a, b string) int { return len(a) - len(b) })
+	})
+	v, ok := m.Get("cc")
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+	assert.Equal(t, 6, m.Len())
+}
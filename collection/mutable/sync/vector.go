@@ -0,0 +1,262 @@
+package sync
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/collection/mutable"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/slice"
+)
+
+// NewVector instantiates a concurrency-safe vector and copies elements to it
+func NewVector[T any](elements ...T) *Vector[T] {
+	return &Vector[T]{elements: slice.Clone(elements)}
+}
+
+// Vector is a concurrency-safe wrapper of the mutable.Vector guarded by a RWMutex.
+// The methods that call user functions work with a snapshot of the vector, so the lock is not held while the functions run.
+type Vector[T any] struct {
+	mu       sync.RWMutex
+	elements mutable.Vector[T]
+}
+
+var (
+	_ c.Addable[any]             = (*Vector[any])(nil)
+	_ c.AddableAll[seq.Seq[any]] = (*Vector[any])(nil)
+	_ c.Deleteable[int]          = (*Vector[any])(nil)
+	_ c.DeleteableVerify[int]    = (*Vector[any])(nil)
+	_ c.Settable[int, any]       = (*Vector[any])(nil)
+	_ c.SettableNew[int, any]    = (*Vector[any])(nil)
+	_ c.OrderedRange[any]        = (*Vector[any])(nil)
+	_ collection.Vector[any]     = (*Vector[any])(nil)
+	_ fmt.Stringer               = (*Vector[any])(nil)
+	_ json.Marshaler             = (*Vector[any])(nil)
+	_ json.Unmarshaler           = (*Vector[any])(nil)
+)
+
+// Update calls the 'update' function with the wrapped vector under the write lock.
+// It is used for multi-step atomic modifications. The vector must not be retained after the function returns.
+func (v *Vector[T]) Update(update func(*mutable.Vector[T])) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	update(&v.elements)
+}
+
+// All is used to iterate through a snapshot of the collection using `for e := range`.
+func (v *Vector[T]) All(consumer func(T) bool) {
+	v.snapshot().All(consumer)
+}
+
+// IAll is used to iterate through a snapshot of the collection using `for i, e := range`.
+func (v *Vector[T]) IAll(consumer func(int, T) bool) {
+	v.snapshot().IAll(consumer)
+}
+
+// Head returns the first element
+func (v *Vector[T]) Head() (T, bool) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.elements.Head()
+}
+
+// Tail returns the latest element
+func (v *Vector[T]) Tail() (T, bool) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.elements.Tail()
+}
+
+// Slice collects the elements to a slice
+func (v *Vector[T]) Slice() []T {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.elements.Slice()
+}
+
+// Append collects the values to the specified 'out' slice
+func (v *Vector[T]) Append(out []T) []T {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.elements.Append(out)
+}
+
+// Clone just makes a copy of the vector instance
+func (v *Vector[T]) Clone() *Vector[T] {
+	return &Vector[T]{elements: v.Slice()}
+}
+
+// IsEmpty returns true if the collection is empty
+func (v *Vector[T]) IsEmpty() bool {
+	return v.Len() == 0
+}
+
+// Len returns amount of elements
+func (v *Vector[T]) Len() int {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.elements.Len()
+}
+
+// TrackEach applies the 'consumer' function to every element of a snapshot
+func (v *Vector[T]) TrackEach(consumer func(int, T)) {
+	v.snapshot().TrackEach(consumer)
+}
+
+// ForEach applies the 'consumer' function to every element of a snapshot
+func (v *Vector[T]) ForEach(consumer func(T)) {
+	v.snapshot().ForEach(consumer)
+}
+
+// Get returns an element by the index, otherwise, if the provided index is ouf of the vector len, returns zero T and false in the second result
+func (v *Vector[T]) Get(index int) (T, bool) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.elements.Get(index)
+}
+
+// Add adds elements to the end of the vector
+func (v *Vector[T]) Add(elements ...T) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.elements.Add(elements...)
+}
+
+// AddOne adds an element to the end of the vector
+func (v *Vector[T]) AddOne(element T) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.elements.AddOne(element)
+}
+
+// AddAll adds all elements from the "other" seq to the end of the vector.
+// The seq is collected before locking.
+func (v *Vector[T]) AddAll(other seq.Seq[T]) {
+	if other != nil {
+		v.Add(other.Slice()...)
+	}
+}
+
+// DeleteOne removes an element by the index
+func (v *Vector[T]) DeleteOne(index int) {
+	_ = v.DeleteActualOne(index)
+}
+
+// DeleteActualOne removes an element by the index
+func (v *Vector[T]) DeleteActualOne(index int) bool {
+	_, ok := v.Remove(index)
+	return ok
+}
+
+// Remove removes and returns an element by the index
+func (v *Vector[T]) Remove(index int) (T, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.elements.Remove(index)
+}
+
+// Delete drops elements by indexes
+func (v *Vector[T]) Delete(indexes ...int) {
+	v.DeleteActual(indexes...)
+}
+
+// DeleteActual drops elements by indexes with verification of no-op
+func (v *Vector[T]) DeleteActual(indexes ...int) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.elements.DeleteActual(indexes...)
+}
+
+// Set puts an element into the vector at the index
+func (v *Vector[T]) Set(index int, value T) {
+	v.SetNew(index, value)
+}
+
+// SetNew puts an element into the vector at the index
+func (v *Vector[T]) SetNew(index int, value T) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.elements.SetNew(index, value)
+}
+
+// Filter returns a seq consisting of elements of a snapshot that satisfy the condition of the 'filter' function
+func (v *Vector[T]) Filter(filter func(T) bool) seq.Seq[T] {
+	return collection.Filter(v, filter)
+}
+
+// Filt returns an errorable seq consisting of elements of a snapshot that satisfy the condition of the 'filter' function
+func (v *Vector[T]) Filt(filter func(T) (bool, error)) seq.SeqE[T] {
+	return collection.Filt(v, filter)
+}
+
+// Convert returns a seq that applies the 'converter' function to the elements of a snapshot
+func (v *Vector[T]) Convert(converter func(T) T) seq.Seq[T] {
+	return collection.Convert(v, converter)
+}
+
+// Conv returns an errorable seq that applies the 'converter' function to the elements of a snapshot
+func (v *Vector[T]) Conv(converter func(T) (T, error)) seq.SeqE[T] {
+	return collection.Conv(v, converter)
+}
+
+// Reduce reduces the elements of a snapshot into an one using the 'merge' function
+func (v *Vector[T]) Reduce(merge func(T, T) T) T {
+	return v.snapshot().Reduce(merge)
+}
+
+// HasAny checks whether a snapshot contains an element that satisfies the condition.
+func (v *Vector[T]) HasAny(condition func(T) bool) bool {
+	return v.snapshot().HasAny(condition)
+}
+
+// First returns the first element of a snapshot that satisfies requirements of the condition.
+func (v *Vector[T]) First(condition func(T) bool) (T, bool) {
+	return v.snapshot().First(condition)
+}
+
+// Sort sorts the Vector in-place under the write lock and returns it
+func (v *Vector[T]) Sort(comparer slice.Comparer[T]) *Vector[T] {
+	v.Update(func(elements *mutable.Vector[T]) { elements.Sort(comparer) })
+	return v
+}
+
+// StableSort stable sorts the Vector in-place under the write lock and returns it
+func (v *Vector[T]) StableSort(comparer slice.Comparer[T]) *Vector[T] {
+	v.Update(func(elements *mutable.Vector[T]) { elements.StableSort(comparer) })
+	return v
+}
+
+// String returns then string representation
+func (v *Vector[T]) String() string {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.elements.String()
+}
+
+func (v *Vector[T]) snapshot() *mutable.Vector[T] {
+	return mutable.WrapVector(v.Slice())
+}
+
+// MarshalJSON encodes a snapshot of the vector as a JSON array
+func (v *Vector[T]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalArray(v.snapshot().All)
+}
+
+// UnmarshalJSON replaces the vector elements by the elements of a JSON array under the write lock
+func (v *Vector[T]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	elements, err := json_.UnmarshalArray[T](data)
+	if err != nil {
+		return err
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.elements = elements
+	return nil
+}
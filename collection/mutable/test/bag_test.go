@@ -0,0 +1,91 @@
+package test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection/mutable"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/slice"
+)
+
+func Test_Bag_AddRemove(t *testing.T) {
+	bag := mutable.NewBag("a", "b", "a")
+	bag.AddN("c", 3)
+
+	assert.Equal(t, 6, bag.Len())
+	assert.Equal(t, 2, bag.Count("a"))
+	assert.Equal(t, 3, bag.Count("c"))
+	assert.Equal(t, 0, bag.Count("d"))
+
+	assert.Equal(t, 2, bag.Remove("c", 2))
+	assert.Equal(t, 1, bag.Remove("c", 5))
+	assert.False(t, bag.Contains("c"))
+
+	bag.DeleteOne("a")
+	assert.Equal(t, 1, bag.Len())
+	assert.Equal(t, slice.Of("b"), bag.Distinct().Slice())
+}
+
+func Test_Bag_Iterate(t *testing.T) {
+	bag := mutable.BagFromSeq(seq.Of(1, 2, 1, 3, 1))
+
+	counts := map[int]int{}
+	for e, count := range bag.All {
+		counts[e] = count
+	}
+	assert.Equal(t, map[int]int{1: 3, 2: 1, 3: 1}, counts)
+
+	elements := bag.Elements().Slice()
+	sort.Ints(elements)
+	assert.Equal(t, slice.Of(1, 1, 1, 2, 3), elements)
+	assert.True(t, bag.Distinct().Contains(3))
+}
+
+func Test_Bag_MostCommon(t *testing.T) {
+	bag := mutable.NewBag("a", "b", "a", "c", "a", "b")
+
+	assert.Equal(t, []c.KV[string, int]{{K: "a", V: 3}, {K: "b", V: 2}}, bag.MostCommon(2))
+	assert.Len(t, bag.MostCommon(10), 3)
+	assert.Nil(t, bag.MostCommon(0))
+	assert.Nil(t, bag.MostCommon(-1))
+
+	ties := mutable.NewBag(5, 3, 9, 1, 7, 3, 9)
+	for range 20 {
+		assert.Equal(t, []c.KV[int, int]{{K: 3, V: 2}, {K: 9, V: 2}, {K: 1, V: 1}, {K: 5, V: 1}, {K: 7, V: 1}}, ties.MostCommon(5))
+	}
+
+	type pair struct{ a, b int }
+	structs := mutable.NewBag(pair{2, 1}, pair{1, 2}, pair{1, 1})
+	for range 20 {
+		assert.Equal(t, []c.KV[pair, int]{{K: pair{1, 1}, V: 1}, {K: pair{1, 2}, V: 1}}, structs.MostCommon(2))
+	}
+}
+
+func Test_Bag_Algebra(t *testing.T) {
+	a := mutable.NewBag(1, 1, 1, 2)
+	b := mutable.NewBag(1, 2, 2, 3)
+
+	assert.Equal(t, map[int]int{1: 4, 2: 3, 3: 1}, a.Sum(b).Map())
+	assert.Equal(t, map[int]int{1: 3, 2: 2, 3: 1}, a.Union(b).Map())
+	assert.Equal(t, map[int]int{1: 1, 2: 1}, a.Intersection(b).Map())
+	assert.Equal(t, map[int]int{1: 2}, a.Difference(b).Map())
+	assert.Equal(t, 4, a.Len())
+}
+
+func Test_Bag_Zero_Safety(t *testing.T) {
+	var bag *mutable.Bag[int]
+
+	bag.Add(1)
+	bag.Remove(1, 1)
+	assert.Equal(t, 0, bag.Count(1))
+	assert.Equal(t, "[]", bag.String())
+	assert.Equal(t, map[int]int{1: 1}, bag.Sum(mutable.NewBag(1)).Map())
+
+	var zero mutable.Bag[int]
+	zero.Add(1, 1)
+	assert.Equal(t, 2, zero.Count(1))
+}
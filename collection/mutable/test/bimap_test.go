@@ -0,0 +1,86 @@
+package test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/collection/mutable"
+	"github.com/m4gshm/gollections/k"
+	"github.com/m4gshm/gollections/seq2"
+	"github.com/m4gshm/gollections/slice"
+)
+
+func Test_BiMap_SetGet(t *testing.T) {
+	m := mutable.NewBiMap(k.V(1, "one"), k.V(2, "two"))
+
+	v, ok := m.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, "one", v)
+
+	key, ok := m.GetKey("two")
+	assert.True(t, ok)
+	assert.Equal(t, 2, key)
+
+	m.Set(1, "uno")
+	assert.False(t, m.ContainsValue("one"))
+	assert.Equal(t, 2, m.Len())
+}
+
+func Test_BiMap_ReplaceOnCollision(t *testing.T) {
+	m := mutable.NewBiMap(k.V(1, "one"), k.V(2, "two"))
+
+	assert.True(t, m.Put(3, "one"))
+	assert.False(t, m.Contains(1))
+	key, _ := m.GetKey("one")
+	assert.Equal(t, 3, key)
+	assert.Equal(t, 2, m.Len())
+}
+
+func Test_BiMap_RejectOnCollision(t *testing.T) {
+	m := mutable.NewBiMapCollision(collection.RejectOnCollision, k.V(1, "one"), k.V(2, "two"), k.V(3, "one"))
+
+	assert.Equal(t, 2, m.Len())
+	assert.False(t, m.Contains(3))
+	assert.False(t, m.Put(3, "two"))
+	assert.True(t, m.Put(1, "one"))
+	assert.False(t, m.SetNew(1, "three"))
+	assert.True(t, m.SetNew(3, "three"))
+}
+
+func Test_BiMap_Inverse(t *testing.T) {
+	m := mutable.NewBiMap(k.V(1, "one"), k.V(2, "two"))
+	inverse := m.Inverse()
+
+	key, ok := inverse.Get("one")
+	assert.True(t, ok)
+	assert.Equal(t, 1, key)
+
+	inverse.Set("three", 3)
+	v, ok := m.Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, "three", v)
+
+	m.Delete(1)
+	assert.False(t, inverse.Contains("one"))
+
+	keys := seq2.Keys(seq2.Filter(m.All, func(_ int, v string) bool { return v != "" })).Slice()
+	sort.Ints(keys)
+	assert.Equal(t, slice.Of(2, 3), keys)
+}
+
+func Test_BiMap_Zero(t *testing.T) {
+	var m mutable.BiMap[int, string]
+
+	m.Inverse().Set("one", 1)
+	v, ok := m.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, "one", v)
+
+	var nilMap *mutable.BiMap[int, string]
+	nilMap.Set(1, "one")
+	assert.Nil(t, nilMap.Inverse())
+	assert.Equal(t, 0, nilMap.Len())
+}
@@ -0,0 +1,97 @@
+package test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/collection/mutable"
+	"github.com/m4gshm/gollections/internal/bitset"
+	"github.com/m4gshm/gollections/slice"
+)
+
+func Test_BitSet_AddDelete(t *testing.T) {
+	set := mutable.NewBitSet[uint](3, 1, 200, 64)
+
+	assert.True(t, set.Contains(200))
+	assert.False(t, set.Contains(2))
+	assert.Equal(t, 4, set.PopCount())
+	assert.Equal(t, slice.Of[uint](1, 3, 64, 200), set.Slice())
+
+	assert.False(t, set.AddOneNew(3))
+	assert.True(t, set.DeleteActualOne(200))
+	assert.False(t, set.DeleteActualOne(200))
+	assert.Equal(t, "[1 3 64]", set.String())
+}
+
+func Test_BitSet_NextSetBit(t *testing.T) {
+	set := mutable.NewBitSet(5, 70, 130)
+
+	next, ok := set.NextSetBit(6)
+	assert.True(t, ok)
+	assert.Equal(t, 70, next)
+	next, _ = set.NextSetBit(-10)
+	assert.Equal(t, 5, next)
+	_, ok = set.NextSetBit(131)
+	assert.False(t, ok)
+}
+
+func Test_BitSet_Algebra(t *testing.T) {
+	a := mutable.NewBitSet(1, 2, 3, 100)
+	b := mutable.NewBitSet(3, 4, 100, 1000)
+
+	assert.Equal(t, slice.Of(1, 2, 3, 4, 100, 1000), a.Union(b).Slice())
+	assert.Equal(t, slice.Of(3, 100), a.Intersection(b).Slice())
+	assert.Equal(t, slice.Of(1, 2), a.Difference(b).Slice())
+	assert.Equal(t, slice.Of(1, 2, 4, 1000), a.Xor(b).Slice())
+	assert.Equal(t, slice.Of(1, 2, 3, 100), a.Slice())
+}
+
+func Test_BitSet_Negative(t *testing.T) {
+	set := mutable.NewBitSet[int]()
+
+	assert.False(t, set.Contains(-1))
+	assert.Panics(t, func() { set.Add(-1) })
+}
+
+func Test_BitSet_Range(t *testing.T) {
+	set := mutable.NewBitSet[uint64]()
+	assert.PanicsWithError(t, "bit set: element out of range [0, 2147483647]: 2147483648", func() { set.Add(math.MaxInt32 + 1) })
+	func() {
+		defer func() {
+			err, _ := recover().(error)
+			assert.ErrorIs(t, err, bitset.ErrRange)
+		}()
+		immutable.NewBitSet(-1)
+	}()
+	assert.Panics(t, func() { set.Add(math.MaxUint64) })
+	assert.False(t, set.Contains(math.MaxUint64))
+	assert.True(t, set.IsEmpty())
+
+	set.Add(1 << 20)
+	assert.Equal(t, slice.Of[uint64](1<<20), set.Slice())
+
+	assert.Panics(t, func() { immutable.NewBitSet[uint64](math.MaxUint64) })
+
+	err := json.Unmarshal([]byte(`[1, 18446744073709551615]`), set)
+	assert.EqualError(t, err, "bit set: element out of range [0, 2147483647]: 18446744073709551615")
+	assert.Error(t, json.Unmarshal([]byte(`[-1]`), mutable.NewBitSet[int]()))
+	var decoded immutable.BitSet[int64]
+	assert.Error(t, json.Unmarshal([]byte(`[2147483648]`), &decoded))
+	assert.Equal(t, slice.Of[uint64](1<<20), set.Slice())
+}
+
+func Test_BitSet_Zero_Safety(t *testing.T) {
+	var set *mutable.BitSet[int]
+	set.Add(1)
+	assert.False(t, set.Contains(1))
+	assert.True(t, set.IsEmpty())
+	assert.Equal(t, slice.Of(1), set.Union(mutable.NewBitSet(1)).Slice())
+
+	var zero mutable.BitSet[int]
+	zero.Add(1)
+	assert.Equal(t, 1, zero.Len())
+}
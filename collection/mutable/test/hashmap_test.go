@@ -0,0 +1,144 @@
+package test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection/mutable"
+	"github.com/m4gshm/gollections/k"
+	"github.com/m4gshm/gollections/slice"
+)
+
+func Test_HashMap_Bytes(t *testing.T) {
+	m := mutable.NewHashMap(mutable.BytesHasher(), k.V([]byte("a"), 1), k.V([]byte("b"), 2))
+
+	v, ok := m.Get([]byte("a"))
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	m.Set([]byte("a"), 10)
+	assert.Equal(t, 2, m.Len())
+	v, _ = m.Get([]byte("a"))
+	assert.Equal(t, 10, v)
+
+	assert.False(t, m.SetNew([]byte("b"), 20))
+	assert.True(t, m.SetNew([]byte("c"), 3))
+
+	v, ok = m.Remove([]byte("b"))
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+	assert.False(t, m.Contains([]byte("b")))
+	assert.Equal(t, 2, m.Len())
+
+	values := m.Values().Slice()
+	sort.Ints(values)
+	assert.Equal(t, slice.Of(3, 10), values)
+}
+
+func Test_HashMap_Slice(t *testing.T) {
+	m := mutable.NewHashMap[[]int, string](mutable.SliceHasher[int]())
+	m.Set(slice.Of(1, 2), "12")
+	m.Set(slice.Of(2, 1), "21")
+
+	v, ok := m.Get(slice.Of(1, 2))
+	assert.True(t, ok)
+	assert.Equal(t, "12", v)
+	_, ok = m.Get(slice.Of(1))
+	assert.False(t, ok)
+	assert.Equal(t, 2, m.Len())
+}
+
+func Test_HashMap_Fold(t *testing.T) {
+	m := mutable.NewHashMap(mutable.FoldHasher(), k.V("Go", 1))
+	m.Set("GO", 2)
+	m.Set("straße", 3)
+
+	assert.Equal(t, 2, m.Len())
+	v, _ := m.Get("go")
+	assert.Equal(t, 2, v)
+	assert.True(t, m.Contains("STRAßE"))
+	assert.True(t, m.Contains("ſtraße"))
+}
+
+func Test_HashMap_Collision(t *testing.T) {
+	constant := mutable.Hasher[string]{
+		Hash:  func(string) uint64 { return 0 },
+		Equal: func(a, b string) bool { return a == b },
+	}
+	m := mutable.NewHashMap(constant, k.V("a", 1), k.V("b", 2), k.V("c", 3))
+
+	m.Delete("a")
+	assert.Equal(t, 2, m.Len())
+	v, _ := m.Get("c")
+	assert.Equal(t, 3, v)
+
+	out := map[string]int{}
+	m.TrackEach(func(key string, value int) { out[key] = value })
+	assert.Equal(t, map[string]int{"b": 2, "c": 3}, out)
+}
+
+func Test_HashMap_Nil(t *testing.T) {
+	var m *mutable.HashMap[[]byte, int]
+	assert.True(t, m.IsEmpty())
+	_, ok := m.Get([]byte("a"))
+	assert.False(t, ok)
+	m.Set([]byte("a"), 1)
+	assert.Equal(t, "[]", m.String())
+}
+
+func Test_HashMap_NoHasher(t *testing.T) {
+	var m mutable.HashMap[[]byte, int]
+	_, ok := m.Get([]byte("a"))
+	assert.False(t, ok)
+	_, ok = m.Remove([]byte("a"))
+	assert.False(t, ok)
+	assert.False(t, m.Contains([]byte("a")))
+	assert.PanicsWithValue(t, mutable.ErrNoHasher, func() { m.Set([]byte("a"), 1) })
+
+	var s mutable.HashSet[[]byte]
+	assert.False(t, s.Contains([]byte("a")))
+	assert.PanicsWithValue(t, mutable.ErrNoHasher, func() { s.Add([]byte("a")) })
+
+	assert.PanicsWithValue(t, mutable.ErrNoHasher, func() { mutable.NewHashMap[[]byte, int](mutable.Hasher[[]byte]{}) })
+	assert.PanicsWithValue(t, mutable.ErrNoHasher, func() { mutable.NewHashSet(mutable.Hasher[[]byte]{Hash: mutable.BytesHasher().Hash}) })
+}
+
+func Test_HashMap_Clone(t *testing.T) {
+	m := mutable.NewHashMap(mutable.BytesHasher(), k.V([]byte("a"), 1))
+	clone := m.Clone()
+	clone.Set([]byte("b"), 2)
+
+	assert.Equal(t, 1, m.Len())
+	assert.Equal(t, 2, clone.Len())
+	_, v, _ := clone.Filter(func(key []byte, _ int) bool { return string(key) == "b" }).Head()
+	assert.Equal(t, 2, v)
+	key, _, _ := m.Head()
+	assert.Equal(t, []byte("a"), key)
+}
+
+func Test_HashSet(t *testing.T) {
+	s := mutable.NewHashSet(mutable.SliceHasher[string](), slice.Of("a", "b"), slice.Of("c"))
+
+	assert.False(t, s.AddOneNew(slice.Of("a", "b")))
+	assert.True(t, s.AddNew(slice.Of("a")))
+	assert.Equal(t, 3, s.Len())
+	assert.True(t, s.Contains(slice.Of("c")))
+
+	assert.True(t, s.DeleteActualOne(slice.Of("c")))
+	assert.False(t, s.DeleteActual(slice.Of("c")))
+
+	sorted := s.Sort(func(a, b []string) int { return len(a) - len(b) })
+	assert.Equal(t, [][]string{{"a"}, {"a", "b"}}, sorted.Slice())
+}
+
+func Test_HashSet_Fold(t *testing.T) {
+	s := mutable.NewHashSet(mutable.FoldHasher(), "Hello", "HELLO", "world")
+	assert.Equal(t, 2, s.Len())
+	assert.True(t, s.HasAny(func(e string) bool { return e == "world" }))
+
+	var nilSet *mutable.HashSet[string]
+	assert.True(t, nilSet.IsEmpty())
+	assert.False(t, nilSet.Contains("a"))
+}
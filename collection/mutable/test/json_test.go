@@ -0,0 +1,169 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/collection/mutable"
+	"github.com/m4gshm/gollections/collection/mutable/ordered"
+	"github.com/m4gshm/gollections/k"
+)
+
+type point struct{ x, y int }
+
+func (p point) MarshalText() ([]byte, error) {
+	return fmt.Appendf(nil, "%d:%d", p.x, p.y), nil
+}
+
+func (p *point) UnmarshalText(text []byte) error {
+	_, err := fmt.Sscanf(string(text), "%d:%d", &p.x, &p.y)
+	return err
+}
+
+func marshal(t *testing.T, value any) string {
+	data, err := json.Marshal(value)
+	assert.NoError(t, err)
+	return string(data)
+}
+
+func Test_JSON_Map(t *testing.T) {
+	m := mutable.NewMap(k.V(2, "b"), k.V(1, "a"))
+	assert.Equal(t, `{"1":"a","2":"b"}`, marshal(t, m))
+
+	var nilMap *mutable.Map[int, string]
+	assert.NoError(t, json.Unmarshal([]byte(`{"3":"c"}`), &nilMap))
+	assert.Equal(t, map[int]string{3: "c"}, nilMap.Map())
+
+	var dto struct{ M *mutable.Map[point, int] }
+	assert.NoError(t, json.Unmarshal([]byte(`{"M":{"1:2":3}}`), &dto))
+	assert.Equal(t, map[point]int{{1, 2}: 3}, dto.M.Map())
+	assert.Equal(t, `{"M":{"1:2":3}}`, marshal(t, dto))
+}
+
+func Test_JSON_OrderedMap(t *testing.T) {
+	m := ordered.NewMap(k.V("z", 1), k.V("a", 2), k.V("m", 3))
+	assert.Equal(t, `{"z":1,"a":2,"m":3}`, marshal(t, m))
+
+	var decoded *ordered.Map[string, int]
+	assert.NoError(t, json.Unmarshal([]byte(`{"y":1,"b":2,"x":3}`), &decoded))
+	assert.Equal(t, []string{"y", "b", "x"}, decoded.Keys().Slice())
+	assert.Equal(t, `{"y":1,"b":2,"x":3}`, marshal(t, decoded))
+
+	keys := ordered.NewMap(k.V(point{2, 1}, "b"), k.V(point{1, 2}, "a"))
+	assert.Equal(t, `{"2:1":"b","1:2":"a"}`, marshal(t, keys))
+	var decodedKeys ordered.Map[point, string]
+	assert.NoError(t, json.Unmarshal([]byte(`{"2:1":"b","1:2":"a"}`), &decodedKeys))
+	assert.Equal(t, []point{{2, 1}, {1, 2}}, decodedKeys.Keys().Slice())
+}
+
+func Test_JSON_Ordered_Set(t *testing.T) {
+	s := ordered.NewSet(3, 1, 2)
+	assert.Equal(t, `[3,1,2]`, marshal(t, s))
+	var decoded ordered.Set[int]
+	assert.NoError(t, json.Unmarshal([]byte(`[5,4,5,6]`), &decoded))
+	assert.Equal(t, []int{5, 4, 6}, decoded.Slice())
+}
+
+func Test_JSON_Ordered_MultiMap(t *testing.T) {
+	m := ordered.NewMultiMap(k.V("b", 1), k.V("a", 2), k.V("b", 3))
+	assert.Equal(t, `{"b":[1,3],"a":[2]}`, marshal(t, m))
+	var decodedMulti ordered.MultiMap[string, int]
+	assert.NoError(t, json.Unmarshal([]byte(`{"b":[1,3],"a":[2]}`), &decodedMulti))
+	assert.Equal(t, `{"b":[1,3],"a":[2]}`, marshal(t, &decodedMulti))
+}
+
+func Test_JSON_SetVector(t *testing.T) {
+	assert.Equal(t, `[1,2,3]`, marshal(t, mutable.NewVector(1, 2, 3)))
+	assert.Equal(t, `[]`, marshal(t, mutable.NewSet[int]()))
+	assert.Equal(t, `[2]`, marshal(t, mutable.NewSet(2)))
+
+	var s mutable.Set[string]
+	assert.NoError(t, json.Unmarshal([]byte(`["a","b","a"]`), &s))
+	assert.Equal(t, 2, s.Len())
+	assert.True(t, s.Contains("b"))
+
+	var v *mutable.Vector[int]
+	assert.NoError(t, json.Unmarshal([]byte(`[1,2]`), &v))
+	assert.Equal(t, []int{1, 2}, v.Slice())
+}
+
+func Test_JSON_Others(t *testing.T) {
+	bag := mutable.NewBag("a", "b", "a")
+	assert.Equal(t, `{"a":2,"b":1}`, marshal(t, bag))
+	var decodedBag mutable.Bag[string]
+	assert.NoError(t, json.Unmarshal([]byte(`{"a":2,"b":1,"c":0}`), &decodedBag))
+	assert.Equal(t, 3, decodedBag.Len())
+
+	bits := mutable.NewBitSet(65, 1, 3)
+	assert.Equal(t, `[1,3,65]`, marshal(t, bits))
+	var decodedBits mutable.BitSet[int]
+	assert.NoError(t, json.Unmarshal([]byte(`[4,2]`), &decodedBits))
+	assert.Equal(t, []int{2, 4}, decodedBits.Slice())
+
+	bimap := mutable.NewBiMapCollision(collection.RejectOnCollision, k.V(1, "a"))
+	assert.Equal(t, `{"1":"a"}`, marshal(t, bimap))
+	assert.NoError(t, json.Unmarshal([]byte(`{"1":"x","2":"x"}`), bimap))
+	assert.Equal(t, map[int]string{1: "x"}, bimap.Map())
+
+	multi := mutable.NewMultiMap(k.V("a", 1), k.V("a", 2))
+	assert.Equal(t, `{"a":[1,2]}`, marshal(t, multi))
+	var decodedMulti mutable.SetMultiMap[string, int]
+	assert.NoError(t, json.Unmarshal([]byte(`{"a":[1,1],"b":[]}`), &decodedMulti))
+	assert.Equal(t, `{"a":[1]}`, marshal(t, &decodedMulti))
+
+	trie := mutable.NewTrie(k.V("to", 1), k.V("tea", 2))
+	assert.Equal(t, `{"tea":2,"to":1}`, marshal(t, trie))
+	var decodedTrie mutable.Trie[int]
+	assert.NoError(t, json.Unmarshal([]byte(`{"in":5}`), &decodedTrie))
+	assert.True(t, decodedTrie.Contains("in"))
+
+	ranges := mutable.NewRangeMap[int, string]()
+	ranges.Put(0, 10, "a")
+	assert.Equal(t, `[{"From":0,"To":10,"Value":"a"}]`, marshal(t, ranges))
+	var decodedRanges mutable.RangeMap[int, string]
+	assert.NoError(t, json.Unmarshal([]byte(`[{"From":0,"To":5,"Value":"a"},{"From":5,"To":7,"Value":"a"}]`), &decodedRanges))
+	assert.Equal(t, 1, decodedRanges.Len())
+
+	rangeSet := mutable.NewRangeSet(mutable.Interval[int]{From: 1, To: 3})
+	assert.Equal(t, `[{"From":1,"To":3}]`, marshal(t, rangeSet))
+	var decodedRangeSet mutable.RangeSet[int]
+	assert.NoError(t, json.Unmarshal([]byte(`[{"From":1,"To":3},{"From":2,"To":4}]`), &decodedRangeSet))
+	assert.Equal(t, []mutable.Interval[int]{{From: 1, To: 4}}, decodedRangeSet.Slice())
+}
+
+func Test_JSON_HashMap(t *testing.T) {
+	m := mutable.NewHashMap(mutable.FoldHasher(), k.V("B", 2), k.V("a", 1))
+	assert.Equal(t, `{"B":2,"a":1}`, marshal(t, m))
+	assert.NoError(t, json.Unmarshal([]byte(`{"X":1,"x":2}`), m))
+	assert.Equal(t, 1, m.Len())
+	value, _ := m.Get("X")
+	assert.Equal(t, 2, value)
+
+	bytesMap := mutable.NewHashMap(mutable.BytesHasher(), k.V([]byte("key"), 1))
+	assert.Equal(t, `{"key":1}`, marshal(t, bytesMap))
+
+	set := mutable.NewHashSet(mutable.FoldHasher())
+	assert.NoError(t, json.Unmarshal([]byte(`["A","a","b"]`), set))
+	assert.Equal(t, 2, set.Len())
+
+	var noHasher mutable.HashMap[string, int]
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"a":1}`), &noHasher), mutable.ErrNoHasher)
+}
+
+func Test_JSON_Errors(t *testing.T) {
+	var m ordered.Map[int, string]
+	err := json.Unmarshal([]byte(`{"x":"a"}`), &m)
+	assert.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), `"x"`))
+
+	assert.Error(t, json.Unmarshal([]byte(`[1]`), &m))
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &m))
+
+	_, err = json.Marshal(ordered.NewMap(k.V(1.5, "a")))
+	assert.Error(t, err)
+}
@@ -0,0 +1,115 @@
+package test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection/mutable"
+	"github.com/m4gshm/gollections/k"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/slice"
+)
+
+func Test_MultiMap_PutGet(t *testing.T) {
+	m := mutable.NewMultiMap(k.V("a", 1), k.V("b", 2), k.V("a", 3))
+	m.Put("a", 1)
+
+	assert.Equal(t, 4, m.Len())
+	assert.Equal(t, 2, m.KeysLen())
+
+	a, ok := m.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, slice.Of(1, 3, 1), a.Slice())
+
+	_, ok = m.Get("c")
+	assert.False(t, ok)
+}
+
+func Test_MultiMap_Remove(t *testing.T) {
+	m := mutable.NewMultiMap(k.V("a", 1), k.V("b", 2), k.V("a", 3))
+
+	assert.True(t, m.Remove("a", 1))
+	assert.False(t, m.Remove("a", 1))
+	assert.Equal(t, 2, m.Len())
+
+	assert.True(t, m.Remove("b", 2))
+	assert.False(t, m.Contains("b"))
+	assert.Equal(t, 1, m.KeysLen())
+
+	removed, ok := m.RemoveAll("a")
+	assert.True(t, ok)
+	assert.Equal(t, slice.Of(3), removed.Slice())
+	assert.True(t, m.IsEmpty())
+}
+
+func Test_MultiMap_FromGroup(t *testing.T) {
+	m := mutable.MultiMapFromSeq2(seq.ToKV(seq.Of(1, 2, 3, 4, 5), func(i int) bool { return i%2 == 0 }, func(i int) int { return i }))
+
+	groups := map[bool][]int{}
+	for key, values := range m.Groups() {
+		groups[key] = values
+	}
+	assert.Equal(t, map[bool][]int{false: {1, 3, 5}, true: {2, 4}}, groups)
+
+	values := []int{}
+	for _, v := range m.All {
+		values = append(values, v)
+	}
+	sort.Ints(values)
+	assert.Equal(t, slice.Of(1, 2, 3, 4, 5), values)
+	assert.Equal(t, groups, m.Immutable().Map())
+}
+
+func Test_MultiMapOrdered(t *testing.T) {
+	m := mutable.NewMultiMapOrdered(k.V("b", 1), k.V("a", 2), k.V("b", 3))
+	m.Put("c", 4)
+
+	keys := []string{}
+	values := []int{}
+	for key, value := range m.All {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+	assert.Equal(t, slice.Of("b", "b", "a", "c"), keys)
+	assert.Equal(t, slice.Of(1, 3, 2, 4), values)
+
+	m.RemoveAll("b")
+	assert.Equal(t, slice.Of("a", "c"), m.Keys().Slice())
+	assert.Equal(t, "[a:[2] c:[4]]", m.String())
+}
+
+func Test_SetMultiMap(t *testing.T) {
+	m := mutable.NewSetMultiMap(k.V("a", 1), k.V("a", 1), k.V("a", 2), k.V("b", 2))
+
+	assert.Equal(t, 3, m.Len())
+	assert.False(t, m.Put("a", 2))
+	assert.True(t, m.Put("b", 3))
+	assert.True(t, m.ContainsValue("b", 3))
+
+	a, ok := m.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, slice.Of(1, 2), a.Sort(func(a, b int) int { return a - b }).Slice())
+
+	assert.True(t, m.Remove("a", 1))
+	assert.True(t, m.Remove("a", 2))
+	assert.False(t, m.Contains("a"))
+	assert.Equal(t, 2, m.Len())
+}
+
+func Test_MultiMap_Zero_Safety(t *testing.T) {
+	var m *mutable.MultiMap[int, string]
+
+	m.Put(1, "1")
+	m.Remove(1, "1")
+	m.RemoveAll(1)
+	_, ok := m.Get(1)
+	assert.False(t, ok)
+	assert.Equal(t, 0, m.Len())
+	assert.Equal(t, "[]", m.String())
+
+	var zero mutable.MultiMap[int, string]
+	zero.Put(1, "1")
+	assert.Equal(t, 1, zero.Len())
+}
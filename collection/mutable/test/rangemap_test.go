@@ -0,0 +1,99 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection/mutable"
+	"github.com/m4gshm/gollections/seq2"
+	"github.com/m4gshm/gollections/slice"
+)
+
+type interval = mutable.Interval[int]
+
+func Test_RangeMap_PutSplit(t *testing.T) {
+	m := mutable.NewRangeMap[int, string]()
+	m.Put(0, 100, "basic")
+	m.Put(20, 30, "pro")
+
+	assert.Equal(t, "[[0, 20):basic [20, 30):pro [30, 100):basic]", m.String())
+
+	v, ok := m.Get(25)
+	assert.True(t, ok)
+	assert.Equal(t, "pro", v)
+	v, _ = m.Get(30)
+	assert.Equal(t, "basic", v)
+	_, ok = m.Get(100)
+	assert.False(t, ok)
+}
+
+func Test_RangeMap_Coalesce(t *testing.T) {
+	m := mutable.NewRangeMap[int, string]()
+	m.Put(0, 10, "a")
+	m.Put(20, 30, "a")
+	m.Put(10, 20, "a")
+
+	assert.Equal(t, 1, m.Len())
+	assert.Equal(t, "[[0, 30):a]", m.String())
+
+	m.Put(5, 25, "b")
+	m.Put(5, 25, "a")
+	assert.Equal(t, "[[0, 30):a]", m.String())
+}
+
+func Test_RangeMap_Overlapping(t *testing.T) {
+	m := mutable.NewRangeMap[int, int]()
+	m.Put(0, 10, 1)
+	m.Put(10, 20, 2)
+	m.Put(30, 40, 3)
+
+	tests := []struct {
+		name     string
+		from, to int
+		expected []interval
+	}{
+		{"several", 5, 31, []interval{{From: 0, To: 10}, {From: 10, To: 20}, {From: 30, To: 40}}},
+		{"inside", 2, 3, []interval{{From: 0, To: 10}}},
+		{"gap", 20, 30, nil},
+		{"empty", 5, 5, nil},
+		{"inverted", 7, 3, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, seq2.Keys(m.Overlapping(tt.from, tt.to)).Slice())
+		})
+	}
+}
+
+func Test_RangeMap_DeleteRange(t *testing.T) {
+	m := mutable.NewRangeMap[int, int]()
+	m.Put(0, 10, 1)
+	m.Put(10, 20, 2)
+	m.DeleteRange(5, 15)
+
+	assert.Equal(t, "[[0, 5):1 [15, 20):2]", m.String())
+	assert.False(t, m.Contains(7))
+}
+
+func Test_RangeSet(t *testing.T) {
+	s := mutable.NewRangeSet(interval{From: 10, To: 20}, interval{From: 0, To: 5}, interval{From: 15, To: 25}, interval{From: 5, To: 7})
+
+	assert.Equal(t, slice.Of(interval{From: 0, To: 7}, interval{From: 10, To: 25}), s.Slice())
+	assert.True(t, s.Contains(6))
+	assert.False(t, s.Contains(7))
+
+	s.DeleteRange(12, 14)
+	assert.Equal(t, "[[0, 7) [10, 12) [14, 25)]", s.String())
+}
+
+func Test_RangeMap_Zero_Safety(t *testing.T) {
+	var m *mutable.RangeMap[int, int]
+	m.Put(0, 1, 1)
+	assert.True(t, m.IsEmpty())
+	assert.Equal(t, "[]", m.String())
+
+	var s mutable.RangeSet[string]
+	s.Add("a", "c")
+	assert.True(t, s.Contains("b"))
+}
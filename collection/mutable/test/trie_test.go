@@ -0,0 +1,82 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/collection/mutable"
+	"github.com/m4gshm/gollections/k"
+	"github.com/m4gshm/gollections/seq2"
+	"github.com/m4gshm/gollections/slice"
+)
+
+func Test_Trie_SetGetDelete(t *testing.T) {
+	trie := mutable.NewTrie(k.V("car", 1), k.V("cat", 2), k.V("ca", 3))
+	trie.Set("", 0)
+	trie.Set("cat", 4)
+
+	assert.Equal(t, 4, trie.Len())
+	v, ok := trie.Get("cat")
+	assert.True(t, ok)
+	assert.Equal(t, 4, v)
+	v, ok = trie.GetBytes([]byte("car"))
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	_, ok = trie.Get("c")
+	assert.False(t, ok)
+
+	v, ok = trie.Remove("ca")
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+	_, ok = trie.Remove("ca")
+	assert.False(t, ok)
+	assert.True(t, trie.Contains("car"))
+	assert.False(t, trie.SetNew("car", 10))
+	assert.Equal(t, 3, trie.Len())
+}
+
+func Test_Trie_Lexicographic(t *testing.T) {
+	trie := mutable.NewTrie(k.V("b", 1), k.V("ab", 2), k.V("a", 3), k.V("abc", 4), k.V("ac", 5))
+
+	assert.Equal(t, slice.Of("a", "ab", "abc", "ac", "b"), seq2.Keys(trie.All).Slice())
+	assert.Equal(t, slice.Of("ab", "abc"), seq2.Keys(trie.WithPrefix("ab")).Slice())
+	assert.Empty(t, seq2.Keys(trie.WithPrefix("x")).Slice())
+	assert.Equal(t, "[a:3 ab:2 abc:4 ac:5 b:1]", trie.String())
+}
+
+func Test_Trie_LongestPrefixOf(t *testing.T) {
+	trie := mutable.NewTrie(k.V("/api", 1), k.V("/api/users", 2), k.V("/", 3))
+
+	key, v, ok := trie.LongestPrefixOf("/api/users/42")
+	assert.True(t, ok)
+	assert.Equal(t, "/api/users", key)
+	assert.Equal(t, 2, v)
+
+	key, _, _ = trie.LongestPrefixOf("/apis")
+	assert.Equal(t, "/api", key)
+
+	_, _, ok = mutable.NewTrie(k.V("a", 1)).LongestPrefixOf("b")
+	assert.False(t, ok)
+}
+
+func Test_Trie_Immutable(t *testing.T) {
+	trie := mutable.NewTrie(k.V("a", 1))
+	snapshot := trie.Immutable()
+	trie.Set("b", 2)
+
+	assert.Equal(t, 1, snapshot.Len())
+	assert.Equal(t, map[string]int{"a": 1}, snapshot.Map())
+}
+
+func Test_Trie_Zero_Safety(t *testing.T) {
+	var trie *mutable.Trie[int]
+	trie.Set("a", 1)
+	_, ok := trie.Get("a")
+	assert.False(t, ok)
+	assert.True(t, trie.IsEmpty())
+
+	var zero mutable.Trie[int]
+	zero.Set("a", 1)
+	assert.Equal(t, 1, zero.Len())
+}
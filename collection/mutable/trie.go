@@ -0,0 +1,286 @@
+package mutable
+
+import (
+	"encoding/json"
+	"fmt"
+
+	converte "github.com/m4gshm/gollections/break/kv/convert"
+	filtere "github.com/m4gshm/gollections/break/kv/predicate"
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/collection"
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/internal/json_"
+	"github.com/m4gshm/gollections/internal/trie"
+	"github.com/m4gshm/gollections/kv/convert"
+	kvfilter "github.com/m4gshm/gollections/kv/predicate"
+	"github.com/m4gshm/gollections/map_"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/seq2"
+)
+
+// Trie is a prefix tree implementation of a map with string keys.
+// The key/value pairs are iterated in lexicographic order of the keys.
+type Trie[V any] struct {
+	root *trie.Node[V]
+	size int
+}
+
+var (
+	_ c.Deleteable[string]                       = (*Trie[any])(nil)
+	_ c.Removable[string, any]                   = (*Trie[any])(nil)
+	_ c.Settable[string, any]                    = (*Trie[any])(nil)
+	_ c.SettableNew[string, any]                 = (*Trie[any])(nil)
+	_ c.SettableMap[c.TrackEach[string, any]]    = (*Trie[any])(nil)
+	_ c.ImmutableMapConvert[immutable.Trie[any]] = (*Trie[any])(nil)
+	_ collection.Map[string, any]                = (*Trie[any])(nil)
+	_ fmt.Stringer                               = (*Trie[any])(nil)
+	_ json.Marshaler                             = (*Trie[any])(nil)
+	_ json.Unmarshaler                           = (*Trie[any])(nil)
+)
+
+// All is used to iterate through the collection in lexicographic order of the keys using `for key, val := range`.
+func (m *Trie[V]) All(consumer func(string, V) bool) {
+	if m != nil {
+		trie.All(m.root, "", consumer)
+	}
+}
+
+// WithPrefix returns a seq of the key/value pairs where the key starts with the prefix
+func (m *Trie[V]) WithPrefix(prefix string) seq.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		if m != nil {
+			trie.All(trie.Find(m.root, prefix), prefix, yield)
+		}
+	}
+}
+
+// LongestPrefixOf returns the longest key of the trie that is a prefix of the 's' string
+func (m *Trie[V]) LongestPrefixOf(s string) (key string, value V, ok bool) {
+	if m != nil {
+		key, value, ok = trie.LongestPrefixOf(m.root, s)
+	}
+	return key, value, ok
+}
+
+// Head returns the first key\value pair.
+func (m *Trie[V]) Head() (string, V, bool) {
+	return seq2.Head(m.All)
+}
+
+// Map collects the key/value pairs into a new map
+func (m *Trie[V]) Map() map[string]V {
+	return seq2.Map(m.All)
+}
+
+// Len returns amount of elements
+func (m *Trie[V]) Len() int {
+	if m == nil {
+		return 0
+	}
+	return m.size
+}
+
+// IsEmpty returns true if the trie is empty
+func (m *Trie[V]) IsEmpty() bool {
+	return collection.IsEmpty(m)
+}
+
+// TrackEach applies the 'consumer' function for every key/value pairs
+func (m *Trie[V]) TrackEach(consumer func(string, V)) {
+	for key, value := range m.All {
+		consumer(key, value)
+	}
+}
+
+// Contains checks is the trie contains a key
+func (m *Trie[V]) Contains(key string) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Get returns the value for a key.
+// If ok==false, then the trie does not contain the key.
+func (m *Trie[V]) Get(key string) (value V, ok bool) {
+	if m != nil {
+		value, ok = trie.Get(m.root, key)
+	}
+	return value, ok
+}
+
+// GetBytes returns the value for a key represented by a byte slice.
+// If ok==false, then the trie does not contain the key.
+func (m *Trie[V]) GetBytes(key []byte) (value V, ok bool) {
+	if m != nil {
+		value, ok = trie.Get(m.root, key)
+	}
+	return value, ok
+}
+
+// Set sets the value for a key
+func (m *Trie[V]) Set(key string, value V) {
+	if m != nil {
+		m.set(key, value)
+	}
+}
+
+// SetBytes sets the value for a key represented by a byte slice
+func (m *Trie[V]) SetBytes(key []byte, value V) {
+	if m != nil {
+		m.set(string(key), value)
+	}
+}
+
+// SetNew sets the value for a key only if the key is not exists in the trie
+func (m *Trie[V]) SetNew(key string, value V) bool {
+	if m == nil || m.Contains(key) {
+		return false
+	}
+	m.set(key, value)
+	return true
+}
+
+func (m *Trie[V]) set(key string, value V) {
+	if m.root == nil {
+		m.root = &trie.Node[V]{}
+	}
+	if trie.Set(m.root, key, value) {
+		m.size++
+	}
+}
+
+// Delete removes value by their keys from the trie
+func (m *Trie[V]) Delete(keys ...string) {
+	for _, key := range keys {
+		m.DeleteOne(key)
+	}
+}
+
+// DeleteOne removes a value by the key from the trie
+func (m *Trie[V]) DeleteOne(key string) {
+	_, _ = m.Remove(key)
+}
+
+// Remove removes value by key and return it
+func (m *Trie[V]) Remove(key string) (value V, ok bool) {
+	if m != nil {
+		if value, ok = trie.Delete(m.root, key); ok {
+			m.size--
+		}
+	}
+	return value, ok
+}
+
+// SetMap inserts all elements from the 'other' map
+func (m *Trie[V]) SetMap(other c.TrackEach[string, V]) {
+	if m == nil || other == nil {
+		return
+	}
+	other.TrackEach(m.Set)
+}
+
+// Immutable converts to an immutable trie instance
+func (m *Trie[V]) Immutable() immutable.Trie[V] {
+	return immutable.TrieFromSeq2(m.All)
+}
+
+// String string representation on the trie
+func (m *Trie[V]) String() string {
+	return map_.ToStringOrdered(seq2.Keys(m.All).Slice(), m.Map())
+}
+
+// FilterKey returns a seq consisting of key/value pairs where the key satisfies the condition of the 'filter' function
+func (m *Trie[V]) FilterKey(filter func(string) bool) seq.Seq2[string, V] {
+	return seq2.Filter(m.All, kvfilter.Key[V](filter))
+}
+
+// FiltKey returns an errorable seq consisting of key/value pairs where the key satisfies the condition of the 'filter' function
+func (m *Trie[V]) FiltKey(filter func(string) (bool, error)) seq.SeqE[c.KV[string, V]] {
+	return seq2.Filt(m.All, filtere.Key[V](filter))
+}
+
+// ConvertKey returns a seq that applies the 'converter' function to keys of the trie
+func (m *Trie[V]) ConvertKey(converter func(string) string) seq.Seq2[string, V] {
+	return seq2.Convert(m.All, convert.Key[V](converter))
+}
+
+// ConvKey returns an errorable seq that applies the 'converter' function to keys of the trie
+func (m *Trie[V]) ConvKey(converter func(string) (string, error)) seq.SeqE[c.KV[string, V]] {
+	return seq2.Conv(m.All, converte.Key[V](converter))
+}
+
+// FilterValue returns a seq consisting of key/value pairs where the value satisfies the condition of the 'filter' function
+func (m *Trie[V]) FilterValue(filter func(V) bool) seq.Seq2[string, V] {
+	return seq2.Filter(m.All, kvfilter.Value[string](filter))
+}
+
+// FiltValue returns an errorable seq consisting of key/value pairs where the value satisfies the condition of the 'filter' function
+func (m *Trie[V]) FiltValue(filter func(V) (bool, error)) seq.SeqE[c.KV[string, V]] {
+	return seq2.Filt(m.All, filtere.Value[string](filter))
+}
+
+// ConvertValue returns a seq that applies the 'converter' function to values of the trie
+func (m *Trie[V]) ConvertValue(converter func(V) V) seq.Seq2[string, V] {
+	return seq2.Convert(m.All, convert.Value[string](converter))
+}
+
+// ConvValue returns an errorable seq that applies the 'converter' function to values of the trie
+func (m *Trie[V]) ConvValue(converter func(V) (V, error)) seq.SeqE[c.KV[string, V]] {
+	return seq2.Conv(m.All, converte.Value[string](converter))
+}
+
+// Filter returns a seq consisting of elements that satisfy the condition of the 'filter' function
+func (m *Trie[V]) Filter(filter func(string, V) bool) seq.Seq2[string, V] {
+	return seq2.Filter(m.All, filter)
+}
+
+// Filt returns an errorable seq consisting of elements that satisfy the condition of the 'filter' function
+func (m *Trie[V]) Filt(filter func(string, V) (bool, error)) seq.SeqE[c.KV[string, V]] {
+	return seq2.Filt(m.All, filter)
+}
+
+// Convert returns a seq that applies the 'converter' function to the collection elements
+func (m *Trie[V]) Convert(converter func(string, V) (string, V)) seq.Seq2[string, V] {
+	return seq2.Convert(m.All, converter)
+}
+
+// Conv returns an errorable seq that applies the 'converter' function to the collection elements
+func (m *Trie[V]) Conv(converter func(string, V) (string, V, error)) seq.SeqE[c.KV[string, V]] {
+	return seq2.Conv(m.All, converter)
+}
+
+// Reduce reduces the key/value pairs of the trie into an one pair using the 'merge' function
+func (m *Trie[V]) Reduce(merge func(string, string, V, V) (string, V)) (rk string, rv V) {
+	first := true
+	for key, value := range m.All {
+		if first {
+			rk, rv = key, value
+			first = false
+		} else {
+			rk, rv = merge(rk, key, rv, value)
+		}
+	}
+	return rk, rv
+}
+
+// HasAny checks whether the trie contains a key\value pair that satisfies the condition.
+func (m *Trie[V]) HasAny(condition func(string, V) bool) bool {
+	return seq2.HasAny(m.All, condition)
+}
+
+// MarshalJSON encodes the trie as a JSON object
+func (m *Trie[V]) MarshalJSON() ([]byte, error) {
+	return json_.MarshalObject(m.All)
+}
+
+// UnmarshalJSON replaces the trie content by the members of a JSON object
+func (m *Trie[V]) UnmarshalJSON(data []byte) error {
+	if json_.IsNull(data) {
+		return nil
+	}
+	pairs, err := json_.UnmarshalPairs[string, V](data)
+	if err != nil {
+		return err
+	}
+	*m = *NewTrie(pairs...)
+	return nil
+}
@@ -2,12 +2,14 @@ package mutable
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 
 	"github.com/m4gshm/gollections/c"
 	"github.com/m4gshm/gollections/collection"
 	"github.com/m4gshm/gollections/seq"
 	"github.com/m4gshm/gollections/slice"
+	"github.com/m4gshm/gollections/slice/sorted"
 )
 
 // WrapVector instantiates Vector using a slise as internal storage
@@ -243,6 +245,146 @@ func (v *Vector[T]) SetNew(index int, value T) bool {
 	return true
 }
 
+// Insert inserts elements at the index shifting the subsequent elements to the end.
+// A negative index counts from the end of the vector. The index equal to the length appends the elements.
+// Returns false if the index is out of range.
+func (v *Vector[T]) Insert(index int, elements ...T) bool {
+	if v == nil {
+		return false
+	}
+	e := *v
+	if index = position(index, len(e)); index < 0 || index > len(e) {
+		return false
+	}
+	*v = slices.Insert(e, index, elements...)
+	return true
+}
+
+// InsertAll inserts all elements from the "other" seq at the index shifting the subsequent elements to the end.
+// A negative index counts from the end of the vector. Returns false if the index is out of range.
+func (v *Vector[T]) InsertAll(index int, other seq.Seq[T]) bool {
+	if other == nil {
+		return v.Insert(index)
+	}
+	return v.Insert(index, other.Slice()...)
+}
+
+// DeleteRange removes the elements in the range [from, to). Negative indexes count from the end of the vector.
+// Returns false if the range is invalid.
+func (v *Vector[T]) DeleteRange(from, to int) bool {
+	if v == nil {
+		return false
+	}
+	e := *v
+	l := len(e)
+	if from, to = position(from, l), position(to, l); from < 0 || to > l || from > to {
+		return false
+	}
+	*v = slices.Delete(e, from, to)
+	return true
+}
+
+// Swap swaps the elements with indexes i and j. Negative indexes count from the end of the vector.
+// Returns false if an index is out of range.
+func (v *Vector[T]) Swap(i, j int) bool {
+	if v == nil {
+		return false
+	}
+	e := *v
+	l := len(e)
+	if i, j = position(i, l), position(j, l); i < 0 || i >= l || j < 0 || j >= l {
+		return false
+	}
+	e[i], e[j] = e[j], e[i]
+	return true
+}
+
+// Move moves the element from the 'from' index to the 'to' index shifting the elements between them.
+// Negative indexes count from the end of the vector. Returns false if an index is out of range.
+func (v *Vector[T]) Move(from, to int) bool {
+	if v == nil {
+		return false
+	}
+	e := *v
+	l := len(e)
+	if from, to = position(from, l), position(to, l); from < 0 || from >= l || to < 0 || to >= l {
+		return false
+	}
+	element := e[from]
+	if from < to {
+		copy(e[from:to], e[from+1:to+1])
+	} else {
+		copy(e[to+1:from+1], e[to:from])
+	}
+	e[to] = element
+	return true
+}
+
+// Reverse reverses the order of the elements in-place and returns the vector
+func (v *Vector[T]) Reverse() *Vector[T] {
+	if v != nil {
+		slices.Reverse(*v)
+	}
+	return v
+}
+
+// Truncate keeps the first n elements and removes the rest.
+// A negative n counts from the end of the vector, so Truncate(-1) removes the last element.
+func (v *Vector[T]) Truncate(n int) {
+	if v == nil {
+		return
+	}
+	e := *v
+	if n = position(n, len(e)); n >= 0 && n < len(e) {
+		clear(e[n:])
+		*v = e[:n]
+	} else if n < 0 {
+		clear(e)
+		*v = e[:0]
+	}
+}
+
+// Retain keeps the elements that satisfy the condition of the 'filter' function and removes the rest in-place
+func (v *Vector[T]) Retain(filter func(T) bool) {
+	if v != nil {
+		*v = slices.DeleteFunc(*v, func(e T) bool { return !filter(e) })
+	}
+}
+
+// Compact replaces consecutive runs of equal elements with a single copy in-place, as by the 'eq' function
+func (v *Vector[T]) Compact(eq func(T, T) bool) {
+	if v != nil {
+		*v = slices.CompactFunc(*v, eq)
+	}
+}
+
+// Grow increases the vector capacity to guarantee space for another n elements
+func (v *Vector[T]) Grow(n int) {
+	if v != nil && n > 0 {
+		*v = slices.Grow(*v, n)
+	}
+}
+
+// IndexOf returns the index of the first element that satisfies the condition, or -1 if none do
+func (v *Vector[T]) IndexOf(condition func(T) bool) int {
+	if v == nil {
+		return -1
+	}
+	return slices.IndexFunc(*v, condition)
+}
+
+// LastIndexOf returns the index of the last element that satisfies the condition, or -1 if none do
+func (v *Vector[T]) LastIndexOf(condition func(T) bool) int {
+	if v != nil {
+		for i := len(*v) - 1; i >= 0; i-- {
+			if condition((*v)[i]) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
 // Filter returns a seq consisting of vector elements matching the filter
 func (v *Vector[T]) Filter(filter func(T) bool) seq.Seq[T] {
 	return collection.Filter(v, filter)
@@ -304,6 +446,64 @@ func (v *Vector[T]) sortBy(sorter func([]T, slice.Comparer[T]) []T, comparer sli
 	return v
 }
 
+// BinarySearch searches for the target in the vector sorted by the comparer and returns the position where the target is found,
+// or the position where the target would appear in the sort order, and a bool saying whether the target is really found.
+func (v *Vector[T]) BinarySearch(target T, comparer slice.Comparer[T]) (int, bool) {
+	return sorted.BinarySearch(v.elements(), target, comparer)
+}
+
+// LowerBound returns the index of the first element that is not less than the target in the vector sorted by the comparer
+func (v *Vector[T]) LowerBound(target T, comparer slice.Comparer[T]) int {
+	return sorted.LowerBound(v.elements(), target, comparer)
+}
+
+// UpperBound returns the index of the first element that is greater than the target in the vector sorted by the comparer
+func (v *Vector[T]) UpperBound(target T, comparer slice.Comparer[T]) int {
+	return sorted.UpperBound(v.elements(), target, comparer)
+}
+
+// EqualRange returns the range [from, to) of the elements that are equal to the target in the vector sorted by the comparer
+func (v *Vector[T]) EqualRange(target T, comparer slice.Comparer[T]) (from, to int) {
+	return sorted.EqualRange(v.elements(), target, comparer)
+}
+
+// InsertSorted inserts the element into the vector sorted by the comparer keeping the sort order.
+// The element is placed after the equal ones.
+func (v *Vector[T]) InsertSorted(element T, comparer slice.Comparer[T]) {
+	if v != nil {
+		*v = sorted.InsertSorted(*v, element, comparer)
+	}
+}
+
+// DeleteSorted removes the first element that is equal to the target from the vector sorted by the comparer.
+// Returns false if there is no such element.
+func (v *Vector[T]) DeleteSorted(target T, comparer slice.Comparer[T]) (ok bool) {
+	if v != nil {
+		*v, ok = sorted.DeleteSorted(*v, target, comparer)
+	}
+	return ok
+}
+
+// Merge returns a new vector that combines elements of both vectors sorted by the comparer keeping all elements
+func (v *Vector[T]) Merge(other *Vector[T], comparer slice.Comparer[T]) *Vector[T] {
+	return WrapVector(sorted.Merge(v.elements(), other.elements(), comparer))
+}
+
+// Union returns a new vector that contains elements of both vectors sorted by the comparer, equal elements are matched pairwise
+func (v *Vector[T]) Union(other *Vector[T], comparer slice.Comparer[T]) *Vector[T] {
+	return WrapVector(sorted.Union(v.elements(), other.elements(), comparer))
+}
+
+// Intersect returns a new vector that contains elements of the vector matched pairwise with equal elements of the other one. Both vectors must be sorted by the comparer
+func (v *Vector[T]) Intersect(other *Vector[T], comparer slice.Comparer[T]) *Vector[T] {
+	return WrapVector(sorted.Intersect(v.elements(), other.elements(), comparer))
+}
+
+// Difference returns a new vector that contains elements of the vector that are not matched pairwise with equal elements of the other one. Both vectors must be sorted by the comparer
+func (v *Vector[T]) Difference(other *Vector[T], comparer slice.Comparer[T]) *Vector[T] {
+	return WrapVector(sorted.Difference(v.elements(), other.elements(), comparer))
+}
+
 // String returns then string representation
 func (v *Vector[T]) String() string {
 	if v == nil {
@@ -311,3 +511,18 @@ func (v *Vector[T]) String() string {
 	}
 	return slice.ToString(*v)
 }
+
+func (v *Vector[T]) elements() []T {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
+// position converts a negative index to the index counted from the end
+func position(index, length int) int {
+	if index < 0 {
+		return length + index
+	}
+	return index
+}
@@ -256,3 +256,103 @@ func Test_Vector_FilterMapReduce(t *testing.T) {
 	s := vector.Of(1, 1, 2, 4, 3, 4).Filter(func(i int) bool { return i%2 == 0 }).Convert(func(i int) int { return i * 2 }).Reduce(op.Sum[int])
 	assert.Equal(t, 20, s)
 }
+
+func Test_Vector_Insert(t *testing.T) {
+	v := vector.Of(1, 2, 3)
+
+	assert.True(t, v.Insert(1, 10, 11))
+	assert.Equal(t, slice.Of(1, 10, 11, 2, 3), v.Slice())
+	assert.True(t, v.Insert(-1, 20))
+	assert.Equal(t, slice.Of(1, 10, 11, 2, 20, 3), v.Slice())
+	assert.True(t, v.Insert(v.Len(), 30))
+	assert.Equal(t, slice.Of(1, 10, 11, 2, 20, 3, 30), v.Slice())
+	assert.False(t, v.Insert(10, 0))
+	assert.False(t, v.Insert(-10, 0))
+
+	assert.True(t, v.InsertAll(0, seq.Of(-1, 0)))
+	assert.Equal(t, slice.Of(-1, 0, 1, 10, 11, 2, 20, 3, 30), v.Slice())
+}
+
+func Test_Vector_DeleteRange(t *testing.T) {
+	v := vector.Of(0, 1, 2, 3, 4, 5)
+
+	assert.True(t, v.DeleteRange(1, 3))
+	assert.Equal(t, slice.Of(0, 3, 4, 5), v.Slice())
+	assert.True(t, v.DeleteRange(-2, v.Len()))
+	assert.Equal(t, slice.Of(0, 3), v.Slice())
+	assert.False(t, v.DeleteRange(1, 0))
+	assert.False(t, v.DeleteRange(0, 5))
+}
+
+func Test_Vector_SwapMoveReverse(t *testing.T) {
+	v := vector.Of(0, 1, 2, 3, 4)
+
+	assert.True(t, v.Swap(0, -1))
+	assert.Equal(t, slice.Of(4, 1, 2, 3, 0), v.Slice())
+	assert.False(t, v.Swap(0, 5))
+
+	assert.True(t, v.Move(0, 3))
+	assert.Equal(t, slice.Of(1, 2, 3, 4, 0), v.Slice())
+	assert.True(t, v.Move(-1, 0))
+	assert.Equal(t, slice.Of(0, 1, 2, 3, 4), v.Slice())
+	assert.False(t, v.Move(0, 5))
+
+	assert.Equal(t, slice.Of(4, 3, 2, 1, 0), v.Reverse().Slice())
+}
+
+func Test_Vector_TruncateRetainCompact(t *testing.T) {
+	v := vector.Of(1, 1, 2, 2, 2, 3, 1, 4)
+
+	v.Compact(func(a, b int) bool { return a == b })
+	assert.Equal(t, slice.Of(1, 2, 3, 1, 4), v.Slice())
+
+	v.Retain(func(e int) bool { return e != 1 })
+	assert.Equal(t, slice.Of(2, 3, 4), v.Slice())
+
+	v.Truncate(-1)
+	assert.Equal(t, slice.Of(2, 3), v.Slice())
+	v.Truncate(5)
+	assert.Equal(t, slice.Of(2, 3), v.Slice())
+	v.Truncate(0)
+	assert.True(t, v.IsEmpty())
+
+	v.Grow(10)
+	assert.GreaterOrEqual(t, cap(*v), 10)
+}
+
+func Test_Vector_IndexOf(t *testing.T) {
+	v := vector.Of(1, 2, 3, 2)
+
+	assert.Equal(t, 1, v.IndexOf(func(e int) bool { return e == 2 }))
+	assert.Equal(t, 3, v.LastIndexOf(func(e int) bool { return e == 2 }))
+	assert.Equal(t, -1, v.IndexOf(func(e int) bool { return e == 5 }))
+
+	var nilVector *mutable.Vector[int]
+	assert.Equal(t, -1, nilVector.LastIndexOf(func(e int) bool { return true }))
+	assert.False(t, nilVector.Insert(0, 1))
+}
+
+func Test_Vector_Sorted(t *testing.T) {
+	compare := func(a, b int) int { return a - b }
+	v := vector.Of(1, 3, 5)
+
+	v.InsertSorted(4, compare)
+	v.InsertSorted(0, compare)
+	assert.Equal(t, slice.Of(0, 1, 3, 4, 5), v.Slice())
+
+	index, ok := v.BinarySearch(4, compare)
+	assert.True(t, ok)
+	assert.Equal(t, 3, index)
+	assert.Equal(t, 2, v.LowerBound(2, compare))
+	assert.Equal(t, 3, v.UpperBound(3, compare))
+
+	assert.True(t, v.DeleteSorted(3, compare))
+	assert.False(t, v.DeleteSorted(3, compare))
+	assert.Equal(t, slice.Of(0, 1, 4, 5), v.Slice())
+
+	other := vector.Of(1, 2, 5)
+	assert.Equal(t, slice.Of(0, 1, 1, 2, 4, 5, 5), v.Merge(other, compare).Slice())
+	assert.Equal(t, slice.Of(0, 1, 2, 4, 5), v.Union(other, compare).Slice())
+	assert.Equal(t, slice.Of(1, 5), v.Intersect(other, compare).Slice())
+	assert.Equal(t, slice.Of(0, 4), v.Difference(other, compare).Slice())
+}
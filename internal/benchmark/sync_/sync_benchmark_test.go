@@ -0,0 +1,87 @@
+package sync_
+
+import (
+	"strconv"
+	"testing"
+
+	syncmap "github.com/m4gshm/gollections/collection/mutable/sync"
+)
+
+const keys = 1024
+
+var keyNames = func() []string {
+	out := make([]string, keys)
+	for i := range out {
+		out[i] = strconv.Itoa(i)
+	}
+	return out
+}()
+
+func Benchmark_Counter_SyncMap(b *testing.B) {
+	m := syncmap.NewMap[string, int]()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			key := keyNames[i%keys]
+			for {
+				old, loaded := m.LoadOrStore(key, 1)
+				if !loaded || syncmap.CompareAndSwap(&m, key, old, old+1) {
+					break
+				}
+			}
+			i++
+		}
+	})
+}
+
+func Benchmark_Counter_ShardedMap(b *testing.B) {
+	m := syncmap.NewShardedMap[string, int](0)
+	sum := func(old, value int) int { return old + value }
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			m.Upsert(keyNames[i%keys], 1, sum)
+			i++
+		}
+	})
+}
+
+func Benchmark_ReadMostly_SyncMap(b *testing.B) {
+	m := syncmap.NewMap[string, int]()
+	for i, key := range keyNames {
+		m.Set(key, i)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%10 == 0 {
+				m.Set(keyNames[i%keys], i)
+			} else {
+				_, _ = m.Get(keyNames[i%keys])
+			}
+			i++
+		}
+	})
+}
+
+func Benchmark_ReadMostly_ShardedMap(b *testing.B) {
+	m := syncmap.NewShardedMap[string, int](0)
+	for i, key := range keyNames {
+		m.Set(key, i)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%10 == 0 {
+				m.Set(keyNames[i%keys], i)
+			} else {
+				_, _ = m.Get(keyNames[i%keys])
+			}
+			i++
+		}
+	})
+}
@@ -0,0 +1,147 @@
+// Package bitset provides word-level operations of the bit set collections
+package bitset
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"golang.org/x/exp/constraints"
+)
+
+const wordSize = 64
+
+// MaxBit is the greatest bit that can be set, it bounds the words of a set by 256 MiB
+const MaxBit = math.MaxInt32
+
+// ErrRange is returned if an element is negative or greater than MaxBit
+var ErrRange = errors.New("bit set: element out of range")
+
+// Bit converts the element to a bit. Returns ErrRange if the element is negative or greater than MaxBit.
+func Bit[T constraints.Integer](element T) (uint64, error) {
+	if element < 0 || uint64(element) > MaxBit {
+		return 0, fmt.Errorf("%w [0, %d]: %d", ErrRange, MaxBit, element)
+	}
+	return uint64(element), nil
+}
+
+// Contains checks whether the bit is set
+func Contains(words []uint64, bit uint64) bool {
+	w := bit / wordSize
+	return w < uint64(len(words)) && words[w]&(1<<(bit%wordSize)) != 0
+}
+
+// Set sets the bit, grows the words if needed and returns true if the bit was not set before.
+// The bit must not be greater than MaxBit, use Bit to check it.
+func Set(words []uint64, bit uint64) ([]uint64, bool) {
+	w := bit / wordSize
+	if n := int(w) + 1; n > len(words) {
+		words = append(words, make([]uint64, n-len(words))...)
+	}
+	mask := uint64(1) << (bit % wordSize)
+	ok := words[w]&mask == 0
+	words[w] |= mask
+	return words, ok
+}
+
+// Clear clears the bit, trims the words and returns true if the bit was set before
+func Clear(words []uint64, bit uint64) ([]uint64, bool) {
+	w := bit / wordSize
+	if w >= uint64(len(words)) {
+		return words, false
+	}
+	mask := uint64(1) << (bit % wordSize)
+	ok := words[w]&mask != 0
+	words[w] &^= mask
+	return Trim(words), ok
+}
+
+// Next returns the first set bit that is greater than or equal to the 'from' bit
+func Next(words []uint64, from uint64) (uint64, bool) {
+	w := from / wordSize
+	if w >= uint64(len(words)) {
+		return 0, false
+	}
+	if word := words[w] >> (from % wordSize); word != 0 {
+		return from + uint64(bits.TrailingZeros64(word)), true
+	}
+	for w++; w < uint64(len(words)); w++ {
+		if word := words[w]; word != 0 {
+			return w*wordSize + uint64(bits.TrailingZeros64(word)), true
+		}
+	}
+	return 0, false
+}
+
+// Count returns the amount of the set bits
+func Count(words []uint64) (count int) {
+	for _, word := range words {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+// All iterates through the set bits in ascending order
+func All(words []uint64, yield func(uint64) bool) {
+	for w, word := range words {
+		for word != 0 {
+			bit := uint64(bits.TrailingZeros64(word))
+			if !yield(uint64(w)*wordSize + bit) {
+				return
+			}
+			word &= word - 1
+		}
+	}
+}
+
+// Union returns new words that contains the bits of both words
+func Union(a, b []uint64) []uint64 {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	out := append([]uint64(nil), a...)
+	for i, word := range b {
+		out[i] |= word
+	}
+	return out
+}
+
+// Intersection returns new words that contains the bits set in both words
+func Intersection(a, b []uint64) []uint64 {
+	out := make([]uint64, min(len(a), len(b)))
+	for i := range out {
+		out[i] = a[i] & b[i]
+	}
+	return Trim(out)
+}
+
+// Difference returns new words that contains the bits of the 'a' words that are not set in the 'b' words
+func Difference(a, b []uint64) []uint64 {
+	out := append([]uint64(nil), a...)
+	for i := range min(len(a), len(b)) {
+		out[i] &^= b[i]
+	}
+	return Trim(out)
+}
+
+// Xor returns new words that contains the bits set in only one of the words
+func Xor(a, b []uint64) []uint64 {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	out := append([]uint64(nil), a...)
+	for i, word := range b {
+		out[i] ^= word
+	}
+	return Trim(out)
+}
+
+// Trim cuts the trailing zero words
+func Trim(words []uint64) []uint64 {
+	n := len(words)
+	for n > 0 && words[n-1] == 0 {
+		n--
+	}
+	return words[:n]
+}
@@ -0,0 +1,223 @@
+// Package json_ provides JSON encoding helpers for the collections
+package json_
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/m4gshm/gollections/c"
+)
+
+// ErrKeyType is returned if a map key cannot be converted to or from a JSON object name
+var ErrKeyType = errors.New("unsupported key type")
+
+// IsNull checks whether the data is the JSON null literal.
+// By convention unmarshalers treat null as a no-op.
+func IsNull(data []byte) bool {
+	return string(bytes.TrimSpace(data)) == "null"
+}
+
+// MarshalArray encodes the elements as a JSON array. Nil seq is encoded as an empty array.
+func MarshalArray[T any](elements func(func(T) bool)) ([]byte, error) {
+	out := []T{}
+	if elements != nil {
+		for e := range elements {
+			out = append(out, e)
+		}
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalArray decodes a JSON array
+func UnmarshalArray[T any](data []byte) ([]T, error) {
+	var out []T
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MarshalObject encodes the key/value pairs as a JSON object keeping the order of the pairs.
+func MarshalObject[K, V any](elements func(func(K, V) bool)) ([]byte, error) {
+	members, err := encodeMembers(elements)
+	if err != nil {
+		return nil, err
+	}
+	return writeObject(members), nil
+}
+
+// MarshalSortedObject encodes the key/value pairs as a JSON object sorted by the names like encoding/json does for Go maps.
+func MarshalSortedObject[K, V any](elements func(func(K, V) bool)) ([]byte, error) {
+	members, err := encodeMembers(elements)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(members, func(a, b member) int { return strings.Compare(a.key, b.key) })
+	return writeObject(members), nil
+}
+
+// UnmarshalObject decodes the members of a JSON object in their order and passes them to the consumer.
+// Null is decoded as no members.
+func UnmarshalObject[K, V any](data []byte, consumer func(K, V)) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	} else if token == nil {
+		return nil
+	} else if token != json.Delim('{') {
+		return fmt.Errorf("json: cannot unmarshal %v into an object", token)
+	}
+	for dec.More() {
+		if token, err = dec.Token(); err != nil {
+			return err
+		}
+		name, _ := token.(string)
+		key, err := DecodeKey[K](name)
+		if err != nil {
+			return err
+		}
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return fmt.Errorf("json: value of %q: %w", name, err)
+		}
+		consumer(key, value)
+	}
+	_, err = dec.Token()
+	return err
+}
+
+// UnmarshalPairs decodes the members of a JSON object to key/value pairs in their order
+func UnmarshalPairs[K, V any](data []byte) ([]c.KV[K, V], error) {
+	var pairs []c.KV[K, V]
+	if err := UnmarshalObject(data, func(key K, value V) { pairs = append(pairs, c.KV[K, V]{K: key, V: value}) }); err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
+
+// UnmarshalGroups decodes the members of a JSON object of arrays to key/value pairs, one pair per array element
+func UnmarshalGroups[K, V any](data []byte) ([]c.KV[K, V], error) {
+	var pairs []c.KV[K, V]
+	if err := UnmarshalObject(data, func(key K, values []V) {
+		for _, value := range values {
+			pairs = append(pairs, c.KV[K, V]{K: key, V: value})
+		}
+	}); err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
+
+// EncodeKey converts a map key to a JSON object name following the encoding/json rules:
+// string keys are used directly, encoding.TextMarshaler keys are marshaled, integer keys are formatted as decimals.
+// Byte slice keys are used as strings too.
+func EncodeKey[K any](key K) (string, error) {
+	v := reflect.ValueOf(&key).Elem()
+	if v.Kind() == reflect.String {
+		return v.String(), nil
+	}
+	if tm, ok := any(key).(encoding.TextMarshaler); ok {
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			return "", nil
+		}
+		text, err := tm.MarshalText()
+		return string(text), err
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return string(v.Bytes()), nil
+		}
+	}
+	return "", fmt.Errorf("json: %w %s", ErrKeyType, v.Type())
+}
+
+// DecodeKey converts a JSON object name to a map key following the encoding/json rules:
+// encoding.TextUnmarshaler keys are unmarshaled, string and byte slice keys are used directly, integer keys are parsed as decimals.
+func DecodeKey[K any](name string) (key K, err error) {
+	if tu, ok := any(&key).(encoding.TextUnmarshaler); ok {
+		err = tu.UnmarshalText([]byte(name))
+		return key, err
+	}
+	v := reflect.ValueOf(&key).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(name)
+		return key, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(name, 10, v.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("json: key %q: %w", name, err)
+		}
+		v.SetInt(n)
+		return key, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(name, 10, v.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("json: key %q: %w", name, err)
+		}
+		v.SetUint(n)
+		return key, nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes([]byte(name))
+			return key, nil
+		}
+	}
+	return key, fmt.Errorf("json: %w %s", ErrKeyType, v.Type())
+}
+
+type member struct {
+	key         string
+	name, value []byte
+}
+
+func encodeMembers[K, V any](elements func(func(K, V) bool)) ([]member, error) {
+	var members []member
+	if elements == nil {
+		return members, nil
+	}
+	for key, value := range elements {
+		name, err := EncodeKey(key)
+		if err != nil {
+			return nil, err
+		}
+		encodedName, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		encodedValue, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member{key: name, name: encodedName, value: encodedValue})
+	}
+	return members, nil
+}
+
+func writeObject(members []member) []byte {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, m := range members {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(m.name)
+		buf.WriteByte(':')
+		buf.Write(m.value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes()
+}
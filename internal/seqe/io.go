@@ -0,0 +1,22 @@
+package seqe
+
+import "io"
+
+// Closing runs the 'iterate' function and closes the reader if it is an io.Closer.
+// The 'iterate' function returns false if the consumer has stopped the iteration.
+// A close error is yielded only if the iteration has not been stopped.
+func Closing[T any](r io.Reader, yield func(T, error) bool, iterate func() bool) {
+	closer, ok := r.(io.Closer)
+	if !ok {
+		iterate()
+		return
+	}
+	completed := false
+	defer func() {
+		if err := closer.Close(); err != nil && completed {
+			var no T
+			yield(no, err)
+		}
+	}()
+	completed = iterate()
+}
@@ -0,0 +1,123 @@
+// Package trie provides the byte-wise prefix tree node used by the trie collections
+package trie
+
+import "sort"
+
+// Node is a prefix tree node. The root node has no label.
+type Node[V any] struct {
+	children []*Node[V]
+	value    V
+	label    byte
+	has      bool
+}
+
+// Get returns the value for a key
+func Get[V any, S ~string | ~[]byte](n *Node[V], key S) (value V, ok bool) {
+	for i := 0; n != nil && i < len(key); i++ {
+		n = n.child(key[i])
+	}
+	if n != nil && n.has {
+		return n.value, true
+	}
+	return value, false
+}
+
+// Set sets the value for a key and returns true if the key is new
+func Set[V any, S ~string | ~[]byte](n *Node[V], key S, value V) bool {
+	for i := 0; i < len(key); i++ {
+		n = n.childOrNew(key[i])
+	}
+	isNew := !n.has
+	n.value, n.has = value, true
+	return isNew
+}
+
+// Delete removes the value of a key and prunes the empty nodes
+func Delete[V any, S ~string | ~[]byte](n *Node[V], key S) (value V, ok bool) {
+	if n == nil {
+		return value, false
+	}
+	if len(key) == 0 {
+		if n.has {
+			value, ok = n.value, true
+			var zero V
+			n.value, n.has = zero, false
+		}
+		return value, ok
+	}
+	i, found := n.search(key[0])
+	if !found {
+		return value, false
+	}
+	child := n.children[i]
+	if value, ok = Delete(child, key[1:]); ok && !child.has && len(child.children) == 0 {
+		n.children = append(n.children[:i], n.children[i+1:]...)
+	}
+	return value, ok
+}
+
+// Find returns the node of a prefix
+func Find[V any, S ~string | ~[]byte](n *Node[V], prefix S) *Node[V] {
+	for i := 0; n != nil && i < len(prefix); i++ {
+		n = n.child(prefix[i])
+	}
+	return n
+}
+
+// LongestPrefixOf returns the longest key that is a prefix of the 's' string
+func LongestPrefixOf[V any, S ~string | ~[]byte](n *Node[V], s S) (key S, value V, ok bool) {
+	for i := 0; n != nil; i++ {
+		if n.has {
+			key, value, ok = s[:i], n.value, true
+		}
+		if i == len(s) {
+			break
+		}
+		n = n.child(s[i])
+	}
+	return key, value, ok
+}
+
+// All iterates through the key/value pairs of a subtree in lexicographic order of the keys.
+// The 'prefix' is the key of the subtree node.
+func All[V any](n *Node[V], prefix string, consumer func(string, V) bool) {
+	if n != nil {
+		walk(n, []byte(prefix), consumer)
+	}
+}
+
+func walk[V any](n *Node[V], key []byte, consumer func(string, V) bool) bool {
+	if n.has && !consumer(string(key), n.value) {
+		return false
+	}
+	for _, child := range n.children {
+		if !walk(child, append(key, child.label), consumer) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n *Node[V]) child(label byte) *Node[V] {
+	if i, ok := n.search(label); ok {
+		return n.children[i]
+	}
+	return nil
+}
+
+func (n *Node[V]) childOrNew(label byte) *Node[V] {
+	i, ok := n.search(label)
+	if ok {
+		return n.children[i]
+	}
+	child := &Node[V]{label: label}
+	n.children = append(n.children, nil)
+	copy(n.children[i+1:], n.children[i:])
+	n.children[i] = child
+	return child
+}
+
+func (n *Node[V]) search(label byte) (int, bool) {
+	i := sort.Search(len(n.children), func(i int) bool { return n.children[i].label >= label })
+	return i, i < len(n.children) && n.children[i].label == label
+}
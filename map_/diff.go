@@ -0,0 +1,90 @@
+package map_
+
+import "maps"
+
+// MapDiff is the difference between two maps
+type MapDiff[K comparable, V any] struct {
+	// Added contains the entries that are present only in the second map
+	Added map[K]V
+	// Removed contains the entries that are present only in the first map
+	Removed map[K]V
+	// Changed contains the old and the new values of the keys that are present in both maps with different values
+	Changed map[K]Change[V]
+}
+
+// Change holds the old and the new values of a key
+type Change[V any] struct {
+	Old, New V
+}
+
+// IsEmpty returns true if the maps are equal
+func (d MapDiff[K, V]) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Diff compares the maps and returns the entries that were added, removed or changed in the 'b' map relative to the 'a' map.
+// The values are compared by the 'eq' function.
+func Diff[M ~map[K]V, K comparable, V any](a, b M, eq func(V, V) bool) MapDiff[K, V] {
+	diff := MapDiff[K, V]{Added: map[K]V{}, Removed: map[K]V{}, Changed: map[K]Change[V]{}}
+	for key, old := range a {
+		if value, ok := b[key]; !ok {
+			diff.Removed[key] = old
+		} else if !eq(old, value) {
+			diff.Changed[key] = Change[V]{Old: old, New: value}
+		}
+	}
+	for key, value := range b {
+		if _, ok := a[key]; !ok {
+			diff.Added[key] = value
+		}
+	}
+	return diff
+}
+
+// Patch applies the diff to the 'elements' map in place and returns it.
+// The removed keys are deleted, the added and the changed ones are set to the new values.
+// If the 'elements' map is nil, a new one is allocated.
+func Patch[M ~map[K]V, K comparable, V any](elements M, diff MapDiff[K, V]) M {
+	if elements == nil {
+		elements = make(M, len(diff.Added))
+	}
+	for key := range diff.Removed {
+		delete(elements, key)
+	}
+	maps.Copy(elements, diff.Added)
+	for key, change := range diff.Changed {
+		elements[key] = change.New
+	}
+	return elements
+}
+
+// Merge combines the maps into a new one. The maps are processed in the order they are passed.
+// The resolv selects a value for a key that is present in several maps. It receives true as the first argument in this case.
+func Merge[M ~map[K]V, K comparable, V any](resolv func(bool, K, V, V) V, elements ...M) M {
+	size := 0
+	for _, m := range elements {
+		size = max(size, len(m))
+	}
+	out := make(M, size)
+	for _, m := range elements {
+		for key, value := range m {
+			old, ok := out[key]
+			out[key] = resolv(ok, key, old, value)
+		}
+	}
+	return out
+}
+
+// Invert swaps keys and values of the map. The keys that share a value are collected into a slice in an unspecified order.
+func Invert[M ~map[K]V, K, V comparable](elements M) map[V][]K {
+	out := make(map[V][]K, len(elements))
+	for key, value := range elements {
+		out[value] = append(out[value], key)
+	}
+	return out
+}
+
+// Equal checks whether the maps contain the same keys with values that satisfy the 'eq' function
+func Equal[M1 ~map[K]V1, M2 ~map[K]V2, K comparable, V1, V2 any](a M1, b M2, eq func(V1, V2) bool) bool {
+	return maps.EqualFunc(a, b, eq)
+}
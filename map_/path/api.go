@@ -0,0 +1,322 @@
+// Package path provides access to values of nested map[string]any and []any trees, like decoded JSON, by paths such as "a.b[2].c"
+package path
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/m4gshm/gollections/seq"
+)
+
+var (
+	// ErrNotFound is returned if a key or an index of a path segment is absent
+	ErrNotFound = errors.New("not found")
+	// ErrType is returned if a value of a path segment has an unexpected type
+	ErrType = errors.New("unexpected type")
+	// ErrSyntax is returned if a path cannot be parsed
+	ErrSyntax = errors.New("invalid syntax")
+)
+
+// Error describes a failure of a path segment
+type Error struct {
+	// Path is the processed path
+	Path string
+	// Segment is the prefix of the path up to and including the failed segment
+	Segment string
+	// Err is the cause, one of ErrNotFound, ErrType or ErrSyntax
+	Err error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("path %q: segment %q: %v", e.Path, e.Segment, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ArrayPolicy defines how DeepMerge combines slices
+type ArrayPolicy int
+
+const (
+	// ReplaceArrays replaces a destination slice by a source one
+	ReplaceArrays ArrayPolicy = iota
+	// AppendArrays appends elements of a source slice to a destination one
+	AppendArrays
+)
+
+// Get returns the value of the path converted to the T type
+func Get[T any](m map[string]any, path string) (T, error) {
+	var no T
+	segments, err := parse(path)
+	if err != nil {
+		return no, err
+	}
+	var current any = m
+	for _, s := range segments {
+		if current, err = s.get(current); err != nil {
+			return no, s.fail(path, err)
+		}
+	}
+	if current == nil && any(no) == nil {
+		// T is an interface type that accepts the nil leaf
+		return no, nil
+	}
+	value, ok := current.(T)
+	if !ok {
+		return no, &Error{Path: path, Segment: path, Err: fmt.Errorf("%w: %T", ErrType, current)}
+	}
+	return value, nil
+}
+
+// Set sets the value of the path creating the absent intermediate maps and slices.
+// A slice is extended by nil elements if the index is out of its length.
+func Set(m map[string]any, path string, value any) error {
+	segments, err := parse(path)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return &Error{Path: path, Segment: segments[0].text(path), Err: fmt.Errorf("%w: nil map", ErrType)}
+	}
+	_, err = set(m, path, segments, value)
+	return err
+}
+
+// Delete removes the key or the slice element of the path
+func Delete(m map[string]any, path string) error {
+	segments, err := parse(path)
+	if err != nil {
+		return err
+	}
+	_, err = remove(m, path, segments)
+	return err
+}
+
+// Walk returns a seq of the leaf values and their paths. Map keys are iterated in the sorted order.
+// Nested empty maps and slices are leaves too, an empty root map yields nothing.
+func Walk(m map[string]any) seq.Seq2[string, any] {
+	return func(yield func(string, any) bool) {
+		if len(m) > 0 {
+			walk(m, "", yield)
+		}
+	}
+}
+
+// DeepMerge merges the 'src' map into the 'dst' one and returns the result.
+// Nested maps are merged recursively, slices are combined according to the policy, other values of the 'src' replace the 'dst' ones.
+// If the 'dst' is nil, then the result is a new map.
+func DeepMerge(dst, src map[string]any, policy ArrayPolicy) map[string]any {
+	if dst == nil {
+		dst = make(map[string]any, len(src))
+	}
+	for key, value := range src {
+		dst[key] = merge(dst[key], value, policy)
+	}
+	return dst
+}
+
+func merge(dst, src any, policy ArrayPolicy) any {
+	switch s := src.(type) {
+	case map[string]any:
+		d, _ := dst.(map[string]any)
+		return DeepMerge(d, s, policy)
+	case []any:
+		if d, ok := dst.([]any); ok && policy == AppendArrays {
+			return append(d, s...)
+		}
+		return slices.Clone(s)
+	}
+	return src
+}
+
+func walk(value any, path string, yield func(string, any) bool) bool {
+	switch v := value.(type) {
+	case map[string]any:
+		if len(v) > 0 {
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				next := key
+				if path != "" {
+					next = path + "." + key
+				}
+				if !walk(v[key], next, yield) {
+					return false
+				}
+			}
+			return true
+		}
+	case []any:
+		if len(v) > 0 {
+			for i, e := range v {
+				if !walk(e, path+"["+strconv.Itoa(i)+"]", yield) {
+					return false
+				}
+			}
+			return true
+		}
+	}
+	return yield(path, value)
+}
+
+func set(current any, path string, segments []segment, value any) (any, error) {
+	s := segments[0]
+	if current == nil {
+		if s.isIndex {
+			current = []any{}
+		} else {
+			current = map[string]any{}
+		}
+	}
+	var child any
+	if len(segments) > 1 {
+		child, _ = s.get(current)
+	}
+	switch c := current.(type) {
+	case map[string]any:
+		if s.isIndex {
+			return nil, s.fail(path, fmt.Errorf("%w: %T", ErrType, current))
+		}
+		if len(segments) > 1 {
+			var err error
+			if value, err = set(child, path, segments[1:], value); err != nil {
+				return nil, err
+			}
+		}
+		c[s.key] = value
+		return c, nil
+	case []any:
+		if !s.isIndex {
+			return nil, s.fail(path, fmt.Errorf("%w: %T", ErrType, current))
+		}
+		if len(segments) > 1 {
+			var err error
+			if value, err = set(child, path, segments[1:], value); err != nil {
+				return nil, err
+			}
+		}
+		if s.index >= len(c) {
+			c = append(c, make([]any, s.index-len(c)+1)...)
+		}
+		c[s.index] = value
+		return c, nil
+	}
+	return nil, s.fail(path, fmt.Errorf("%w: %T", ErrType, current))
+}
+
+func remove(current any, path string, segments []segment) (any, error) {
+	s := segments[0]
+	child, err := s.get(current)
+	if err != nil {
+		return nil, s.fail(path, err)
+	}
+	if len(segments) > 1 {
+		if child, err = remove(child, path, segments[1:]); err != nil {
+			return nil, err
+		}
+		switch c := current.(type) {
+		case map[string]any:
+			c[s.key] = child
+		case []any:
+			c[s.index] = child
+		}
+		return current, nil
+	}
+	switch c := current.(type) {
+	case map[string]any:
+		delete(c, s.key)
+		return c, nil
+	case []any:
+		return slices.Delete(c, s.index, s.index+1), nil
+	}
+	return current, nil
+}
+
+type segment struct {
+	key     string
+	index   int
+	isIndex bool
+	end     int
+}
+
+func (s segment) text(path string) string {
+	return path[:s.end]
+}
+
+func (s segment) fail(path string, err error) error {
+	return &Error{Path: path, Segment: s.text(path), Err: err}
+}
+
+func (s segment) get(current any) (any, error) {
+	switch c := current.(type) {
+	case map[string]any:
+		if s.isIndex {
+			break
+		}
+		value, ok := c[s.key]
+		if !ok {
+			return nil, ErrNotFound
+		}
+		return value, nil
+	case []any:
+		if !s.isIndex {
+			break
+		}
+		if s.index >= len(c) {
+			return nil, fmt.Errorf("%w: index out of range [%d] with length %d", ErrNotFound, s.index, len(c))
+		}
+		return c[s.index], nil
+	}
+	return nil, fmt.Errorf("%w: %T", ErrType, current)
+}
+
+func parse(path string) ([]segment, error) {
+	var segments []segment
+	for i := 0; i < len(path); {
+		if path[i] == '[' {
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, &Error{Path: path, Segment: path, Err: fmt.Errorf("%w: unclosed bracket", ErrSyntax)}
+			}
+			end += i
+			index, err := strconv.Atoi(path[i+1 : end])
+			if err != nil || index < 0 {
+				return nil, &Error{Path: path, Segment: path[:end+1], Err: fmt.Errorf("%w: bad index %q", ErrSyntax, path[i+1:end])}
+			}
+			i = end + 1
+			segments = append(segments, segment{index: index, isIndex: true, end: i})
+			if i < len(path) && path[i] != '.' && path[i] != '[' {
+				return nil, &Error{Path: path, Segment: path[:i+1], Err: fmt.Errorf("%w: unexpected %q after index", ErrSyntax, path[i])}
+			}
+		} else {
+			end := strings.IndexAny(path[i:], ".[")
+			if end < 0 {
+				end = len(path)
+			} else {
+				end += i
+			}
+			if end == i {
+				return nil, &Error{Path: path, Segment: path[:i+1], Err: fmt.Errorf("%w: empty key", ErrSyntax)}
+			}
+			segments = append(segments, segment{key: path[i:end], end: end})
+			i = end
+		}
+		if i < len(path) && path[i] == '.' {
+			if i++; i == len(path) {
+				return nil, &Error{Path: path, Segment: path, Err: fmt.Errorf("%w: empty key", ErrSyntax)}
+			}
+		}
+	}
+	if len(segments) == 0 {
+		return nil, &Error{Path: path, Segment: path, Err: fmt.Errorf("%w: empty path", ErrSyntax)}
+	}
+	return segments, nil
+}
@@ -0,0 +1,151 @@
+package test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/map_/path"
+)
+
+func decode(t *testing.T, data string) map[string]any {
+	var m map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(data), &m))
+	return m
+}
+
+func Test_Get(t *testing.T) {
+	m := decode(t, `{"a": {"b": [1, 2, {"c": "value"}]}, "n": 1.5}`)
+
+	c, err := path.Get[string](m, "a.b[2].c")
+	assert.NoError(t, err)
+	assert.Equal(t, "value", c)
+
+	n, err := path.Get[float64](m, "n")
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, n)
+
+	b, err := path.Get[[]any](m, "a.b")
+	assert.NoError(t, err)
+	assert.Len(t, b, 3)
+}
+
+func Test_Get_Errors(t *testing.T) {
+	m := decode(t, `{"a": {"b": [1, 2]}}`)
+
+	_, err := path.Get[any](m, "a.x.c")
+	assert.True(t, errors.Is(err, path.ErrNotFound))
+	var pathErr *path.Error
+	assert.True(t, errors.As(err, &pathErr))
+	assert.Equal(t, "a.x", pathErr.Segment)
+	assert.Equal(t, `path "a.x.c": segment "a.x": not found`, err.Error())
+
+	_, err = path.Get[any](m, "a.b[5]")
+	assert.True(t, errors.Is(err, path.ErrNotFound))
+	assert.True(t, errors.As(err, &pathErr))
+	assert.Equal(t, "a.b[5]", pathErr.Segment)
+
+	_, err = path.Get[any](m, "a.b.c")
+	assert.True(t, errors.Is(err, path.ErrType))
+	assert.True(t, errors.As(err, &pathErr))
+	assert.Equal(t, "a.b.c", pathErr.Segment)
+
+	_, err = path.Get[string](m, "a.b[0]")
+	assert.True(t, errors.Is(err, path.ErrType))
+
+	for _, bad := range []string{"", "a.", "a..b", "a[x]", "a[1", "a[1]b", ".a", "a[-1]"} {
+		_, err = path.Get[any](m, bad)
+		assert.True(t, errors.Is(err, path.ErrSyntax), bad)
+	}
+}
+
+func Test_Set(t *testing.T) {
+	m := map[string]any{}
+
+	assert.NoError(t, path.Set(m, "a.b[2].c", "value"))
+	assert.Equal(t, map[string]any{"a": map[string]any{"b": []any{nil, nil, map[string]any{"c": "value"}}}}, m)
+
+	assert.NoError(t, path.Set(m, "a.b[0]", 1))
+	assert.NoError(t, path.Set(m, "a.b[2].d", true))
+	assert.NoError(t, path.Set(m, "x", "y"))
+	assert.Equal(t, map[string]any{
+		"a": map[string]any{"b": []any{1, nil, map[string]any{"c": "value", "d": true}}},
+		"x": "y",
+	}, m)
+
+	err := path.Set(m, "x.y", 1)
+	assert.True(t, errors.Is(err, path.ErrType))
+	var pathErr *path.Error
+	assert.True(t, errors.As(err, &pathErr))
+	assert.Equal(t, "x.y", pathErr.Segment)
+
+	assert.True(t, errors.Is(path.Set(m, "a[0]", 1), path.ErrType))
+}
+
+func Test_Delete(t *testing.T) {
+	m := decode(t, `{"a": {"b": [1, 2, 3], "c": "d"}}`)
+
+	assert.NoError(t, path.Delete(m, "a.b[1]"))
+	assert.NoError(t, path.Delete(m, "a.c"))
+	assert.Equal(t, map[string]any{"a": map[string]any{"b": []any{1.0, 3.0}}}, m)
+
+	assert.True(t, errors.Is(path.Delete(m, "a.c"), path.ErrNotFound))
+	assert.True(t, errors.Is(path.Delete(m, "a.b[2]"), path.ErrNotFound))
+}
+
+func Test_Walk(t *testing.T) {
+	m := decode(t, `{"b": [1, {"c": null}], "a": {"x": "y", "e": {}}}`)
+
+	var paths []string
+	var values []any
+	for p, v := range path.Walk(m) {
+		paths = append(paths, p)
+		values = append(values, v)
+	}
+	assert.Equal(t, []string{"a.e", "a.x", "b[0]", "b[1].c"}, paths)
+	assert.Equal(t, []any{map[string]any{}, "y", 1.0, nil}, values)
+
+	for p, v := range path.Walk(m) {
+		got, err := path.Get[any](m, p)
+		assert.NoError(t, err)
+		assert.Equal(t, v, got)
+	}
+
+	for p, v := range path.Walk(map[string]any{}) {
+		t.Errorf("unexpected leaf %q: %v", p, v)
+	}
+	for p, v := range path.Walk(nil) {
+		t.Errorf("unexpected leaf %q: %v", p, v)
+	}
+}
+
+func Test_DeepMerge(t *testing.T) {
+	dst := decode(t, `{"a": {"b": 1, "list": [1]}, "c": "d"}`)
+	src := decode(t, `{"a": {"e": 2, "list": [2]}, "c": {"f": 3}}`)
+
+	path.DeepMerge(dst, src, path.AppendArrays)
+	assert.Equal(t, decode(t, `{"a": {"b": 1, "e": 2, "list": [1, 2]}, "c": {"f": 3}}`), dst)
+
+	path.DeepMerge(dst, decode(t, `{"a": {"list": [3]}}`), path.ReplaceArrays)
+	assert.Equal(t, decode(t, `{"a": {"b": 1, "e": 2, "list": [3]}, "c": {"f": 3}}`), dst)
+
+	src["c"].(map[string]any)["f"] = 4.0
+	f, _ := path.Get[float64](dst, "c.f")
+	assert.Equal(t, 3.0, f)
+}
+
+func Test_DeepMerge_Nil(t *testing.T) {
+	src := decode(t, `{"a": {"b": [1]}}`)
+	merged := path.DeepMerge(nil, src, path.AppendArrays)
+	assert.Equal(t, src, merged)
+
+	src["a"].(map[string]any)["c"] = 2.0
+	_, err := path.Get[float64](merged, "a.c")
+	assert.ErrorIs(t, err, path.ErrNotFound)
+
+	dst := map[string]any{"a": map[string]any(nil)}
+	assert.Equal(t, decode(t, `{"a": {"b": [1], "c": 2}}`), path.DeepMerge(dst, src, path.AppendArrays))
+	assert.Equal(t, map[string]any{}, path.DeepMerge(nil, nil, path.AppendArrays))
+}
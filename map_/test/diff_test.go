@@ -0,0 +1,58 @@
+package test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/map_"
+	"github.com/m4gshm/gollections/map_/resolv"
+)
+
+func eq(a, b int) bool { return a == b }
+
+func Test_Diff(t *testing.T) {
+	a := map[string]int{"a": 1, "b": 2, "c": 3}
+	b := map[string]int{"b": 2, "c": 30, "d": 4}
+
+	diff := map_.Diff(a, b, eq)
+	assert.Equal(t, map[string]int{"d": 4}, diff.Added)
+	assert.Equal(t, map[string]int{"a": 1}, diff.Removed)
+	assert.Equal(t, map[string]map_.Change[int]{"c": {Old: 3, New: 30}}, diff.Changed)
+	assert.False(t, diff.IsEmpty())
+	assert.True(t, map_.Diff(a, map_.Clone(a), eq).IsEmpty())
+
+	patched := map_.Patch(map_.Clone(a), diff)
+	assert.Equal(t, b, patched)
+	assert.Equal(t, map[string]int{"d": 4, "c": 30}, map_.Patch(map[string]int(nil), diff))
+}
+
+func Test_Merge(t *testing.T) {
+	a := map[string]int{"a": 1, "b": 2}
+	b := map[string]int{"b": 20, "c": 3}
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3}, map_.Merge(resolv.First[string, int], a, b))
+	assert.Equal(t, map[string]int{"a": 1, "b": 20, "c": 3}, map_.Merge(resolv.Last[string, int], a, b))
+	sum := func(exists bool, _ string, old, value int) int { return old + value }
+	assert.Equal(t, map[string]int{"a": 1, "b": 22, "c": 3}, map_.Merge(sum, a, b))
+	assert.Empty(t, map_.Merge[map[string]int](sum))
+}
+
+func Test_Invert(t *testing.T) {
+	inverted := map_.Invert(map[string]int{"a": 1, "b": 2, "c": 1})
+	for _, keys := range inverted {
+		sort.Strings(keys)
+	}
+	assert.Equal(t, map[int][]string{1: {"a", "c"}, 2: {"b"}}, inverted)
+}
+
+func Test_Equal(t *testing.T) {
+	a := map[int]string{1: "A", 2: "b"}
+	b := map[int]string{1: "a", 2: "B"}
+
+	assert.True(t, map_.Equal(a, b, strings.EqualFold))
+	assert.False(t, map_.Equal(a, b, func(x, y string) bool { return x == y }))
+	assert.False(t, map_.Equal(a, map[int]string{1: "a"}, strings.EqualFold))
+}
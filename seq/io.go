@@ -0,0 +1,41 @@
+package seq
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// WriteTo writes the elements of the 'seq' sequence converted by the 'formatter' to the 'w' writer through a buffer.
+// No separators are added, the formatter is responsible for them, e.g. fmt.Sprintln.
+// Returns the number of bytes written to the 'w'. A write error is wrapped with the element index and stops the writing,
+// an error of the final buffer flush is wrapped with the index of the last element.
+func WriteTo[S ~seq[T], T any](w io.Writer, seq S, formatter func(T) string) (int64, error) {
+	if seq == nil {
+		return 0, nil
+	}
+	counter := &countingWriter{w: w}
+	out := bufio.NewWriter(counter)
+	i := 0
+	for e := range seq {
+		if _, err := out.WriteString(formatter(e)); err != nil {
+			return counter.n, fmt.Errorf("element %d: %w", i, err)
+		}
+		i++
+	}
+	if err := out.Flush(); err != nil {
+		return counter.n, fmt.Errorf("element %d: %w", i-1, err)
+	}
+	return counter.n, nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
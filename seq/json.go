@@ -0,0 +1,48 @@
+package seq
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// EncodeJSONArray writes the elements of the 'seq' sequence to the 'w' writer as a JSON array, one element at a time.
+// An encoding or writing error is wrapped with the element index. A nil seq is written as an empty array.
+func EncodeJSONArray[S ~seq[T], T any](w io.Writer, seq S) error {
+	out := bufio.NewWriter(w)
+	if err := encodeJSONArray(out, seq); err != nil {
+		// the elements written before are flushed to make the failure point visible
+		_ = out.Flush()
+		return err
+	}
+	return out.Flush()
+}
+
+func encodeJSONArray[S ~seq[T], T any](out *bufio.Writer, seq S) error {
+	if err := out.WriteByte('['); err != nil {
+		return err
+	}
+	i := 0
+	if seq != nil {
+		for e := range seq {
+			data, err := json.Marshal(e)
+			if err != nil {
+				return fmt.Errorf("element %d: %w", i, err)
+			}
+			if i > 0 {
+				if err := out.WriteByte(','); err != nil {
+					return fmt.Errorf("element %d: %w", i, err)
+				}
+			}
+			if _, err := out.Write(data); err != nil {
+				return fmt.Errorf("element %d: %w", i, err)
+			}
+			i++
+		}
+	}
+	if err := out.WriteByte(']'); err != nil {
+		return fmt.Errorf("element %d: %w", i, err)
+	}
+	return nil
+}
@@ -1,10 +1,12 @@
 package test
 
 import (
+	"bytes"
 	"errors"
 	"iter"
 	"slices"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/m4gshm/gollections/convert/as"
@@ -881,3 +883,51 @@ func Test_Group(t *testing.T) {
 	assert.Equal(t, slice.Of(2, 4), groups[true])
 	assert.Equal(t, slice.Of(1, 1, 3, 1), groups[false])
 }
+
+func Test_EncodeJSONArray(t *testing.T) {
+	var out bytes.Buffer
+	assert.NoError(t, seq.EncodeJSONArray(&out, seq.Of(1, 2, 3)))
+	assert.Equal(t, "[1,2,3]", out.String())
+
+	out.Reset()
+	assert.NoError(t, seq.EncodeJSONArray(&out, seq.Of[int]()))
+	assert.Equal(t, "[]", out.String())
+
+	out.Reset()
+	err := seq.EncodeJSONArray(&out, seq.Of[any](1, make(chan int)))
+	assert.ErrorContains(t, err, "element 1:")
+	assert.Equal(t, "[1", out.String())
+
+	// the first element fills the write buffer, so the next write fails
+	long := strings.Repeat("a", 4093)
+	err = seq.EncodeJSONArray(&failingWriter{limit: 10}, seq.Of(long, "b"))
+	assert.EqualError(t, err, "element 1: no space")
+	err = seq.EncodeJSONArray(&failingWriter{limit: 10}, seq.Of(long))
+	assert.EqualError(t, err, "element 1: no space")
+}
+
+type failingWriter struct{ limit int }
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		return w.limit, errors.New("no space")
+	}
+	w.limit -= len(p)
+	return len(p), nil
+}
+
+func Test_WriteTo(t *testing.T) {
+	var out bytes.Buffer
+	n, err := seq.WriteTo(&out, seq.Of(1, 2, 3), func(i int) string { return strconv.Itoa(i) + ";" })
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), n)
+	assert.Equal(t, "1;2;3;", out.String())
+
+	n, err = seq.WriteTo(&failingWriter{limit: 3}, seq.Of(1, 2, 3), func(i int) string { return strconv.Itoa(i) + ";" })
+	assert.EqualError(t, err, "element 2: no space")
+	assert.Equal(t, int64(3), n)
+
+	n, err = seq.WriteTo(&failingWriter{limit: 5000}, seq.Of(1, 2, 3, 4), func(i int) string { return strings.Repeat(strconv.Itoa(i), 3000) })
+	assert.EqualError(t, err, "element 2: no space")
+	assert.Equal(t, int64(5000), n)
+}
@@ -0,0 +1,220 @@
+// Package csv provides streaming reading and writing of CSV data as sequences of records or structs
+package csv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/m4gshm/gollections/internal/seqe"
+	"github.com/m4gshm/gollections/seq"
+)
+
+var (
+	// ErrUnsupportedType is returned if a struct field or the row type cannot be converted from or to CSV
+	ErrUnsupportedType = errors.New("unsupported type")
+	// ErrUnknownColumn is returned if a header column matches no struct field and Options.DisallowUnknownColumns is set
+	ErrUnknownColumn = errors.New("unknown column")
+)
+
+// Error describes a failure of a CSV line
+type Error struct {
+	// Line is the line number, starting from 1
+	Line int
+	// Column is the header name of the failed field, if any
+	Column string
+	// Err is the cause
+	Err error
+}
+
+func (e *Error) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+	}
+	return fmt.Sprintf("line %d, column %q: %v", e.Line, e.Column, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Options configures reading and writing of CSV data
+type Options struct {
+	// Comma is the field delimiter, ',' by default
+	Comma rune
+	// Comment is the line comment character, comments are disabled by default. It is not used for writing
+	Comment rune
+	// TimeLayout is the layout of time.Time fields, time.RFC3339Nano by default
+	TimeLayout string
+	// DisallowUnknownColumns makes the header columns that match no struct field an error. It is not used for writing
+	DisallowUnknownColumns bool
+}
+
+// ReadRecords returns a seq that reads the raw records of the 'r' reader, including the header if there is one.
+// A parse error carries the line number, the iteration continues after it if the consumer does not stop it.
+// If the reader is an io.Closer it is closed when the iteration completes or is stopped early.
+func ReadRecords(r io.Reader) seq.SeqE[[]string] {
+	return func(yield func([]string, error) bool) {
+		seqe.Closing(r, yield, func() bool {
+			reader := newReader(r, Options{})
+			for {
+				record, err := reader.Read()
+				if err == io.EOF {
+					return true
+				} else if err != nil {
+					if !yield(nil, err) || !isParseError(err) {
+						return false
+					}
+				} else if !yield(record, nil) {
+					return false
+				}
+			}
+		})
+	}
+}
+
+// Read returns a seq that reads the records of the 'r' reader into structs of the T type.
+// The first record is the header. The columns are matched with the fields by the `csv:"name"` tags,
+// or by the field names case-insensitively if there is no tag. The fields tagged `csv:"-"` and the unexported ones are skipped.
+// Supported field types are strings, booleans, numbers, time.Time, time.Duration, encoding.TextUnmarshaler and pointers to them.
+// Empty cells leave the fields zero.
+// A parse or conversion error carries the line number, the iteration continues after it if the consumer does not stop it.
+// If the reader is an io.Closer it is closed when the iteration completes or is stopped early.
+func Read[T any](r io.Reader, opts Options) seq.SeqE[T] {
+	return func(yield func(T, error) bool) {
+		seqe.Closing(r, yield, func() bool {
+			var no T
+			fields, err := structFields(reflect.TypeFor[T]())
+			if err != nil {
+				return yield(no, err)
+			}
+			reader := newReader(r, opts)
+			header, err := reader.Read()
+			if err == io.EOF {
+				return true
+			} else if err != nil {
+				return yield(no, err)
+			}
+			columns, err := mapColumns(header, fields, opts.DisallowUnknownColumns)
+			if err != nil {
+				return yield(no, &Error{Line: 1, Err: err})
+			}
+			layout := timeLayout(opts)
+			for {
+				record, err := reader.Read()
+				if err == io.EOF {
+					return true
+				} else if err != nil {
+					if !yield(no, err) || !isParseError(err) {
+						return false
+					}
+					continue
+				}
+				line, _ := reader.FieldPos(0)
+				var row T
+				value := reflect.ValueOf(&row).Elem()
+				failed := false
+				for i, cell := range record {
+					if field := columns[i]; field != nil {
+						if err := decode(value.FieldByIndex(field.index), cell, layout); err != nil {
+							failed = true
+							if !yield(no, &Error{Line: line, Column: header[i], Err: err}) {
+								return false
+							}
+							break
+						}
+					}
+				}
+				if !failed && !yield(row, nil) {
+					return false
+				}
+			}
+		})
+	}
+}
+
+// Write writes the header built from the fields of the T type followed by the rows of the 'seq' sequence to the 'w' writer.
+// The header names and the field types follow the Read rules, the delimiter and the time.Time fields layout are taken from the options.
+// An error carries the line number and stops the writing; the lines before it are written anyway.
+func Write[T any](w io.Writer, seq seq.SeqE[T], opts Options) error {
+	fields, err := structFields(reflect.TypeFor[T]())
+	if err != nil {
+		return err
+	}
+	writer := csv.NewWriter(w)
+	if opts.Comma != 0 {
+		writer.Comma = opts.Comma
+	}
+	header := make([]string, len(fields))
+	for i, f := range fields {
+		header[i] = f.name
+	}
+	if err := writer.Write(header); err != nil {
+		return &Error{Line: 1, Err: err}
+	}
+	layout := timeLayout(opts)
+	line := 2
+	if seq != nil {
+		record := make([]string, len(fields))
+		for row, err := range seq {
+			if err != nil {
+				writer.Flush()
+				return &Error{Line: line, Err: err}
+			}
+			value := reflect.ValueOf(&row).Elem()
+			for i, f := range fields {
+				if record[i], err = encode(value.FieldByIndex(f.index), layout); err != nil {
+					writer.Flush()
+					return &Error{Line: line, Column: f.name, Err: err}
+				}
+			}
+			if err := writer.Write(record); err != nil {
+				return &Error{Line: line, Err: err}
+			}
+			line++
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func newReader(r io.Reader, opts Options) *csv.Reader {
+	reader := csv.NewReader(r)
+	if opts.Comma != 0 {
+		reader.Comma = opts.Comma
+	}
+	reader.Comment = opts.Comment
+	return reader
+}
+
+func isParseError(err error) bool {
+	var parseErr *csv.ParseError
+	return errors.As(err, &parseErr)
+}
+
+func timeLayout(opts Options) string {
+	if opts.TimeLayout != "" {
+		return opts.TimeLayout
+	}
+	return time.RFC3339Nano
+}
+
+func mapColumns(header []string, fields []field, disallowUnknown bool) ([]*field, error) {
+	columns := make([]*field, len(header))
+	for i, name := range header {
+		for j := range fields {
+			if f := &fields[j]; f.name == name || (!f.tagged && strings.EqualFold(f.name, name)) {
+				columns[i] = f
+				break
+			}
+		}
+		if columns[i] == nil && disallowUnknown {
+			return nil, fmt.Errorf("%w %q", ErrUnknownColumn, name)
+		}
+	}
+	return columns, nil
+}
@@ -0,0 +1,158 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/seqe"
+	"github.com/m4gshm/gollections/seqe/csv"
+)
+
+type level int
+
+func (l level) MarshalText() ([]byte, error) {
+	return []byte(strings.Repeat("*", int(l))), nil
+}
+
+func (l *level) UnmarshalText(text []byte) error {
+	if strings.Trim(string(text), "*") != "" {
+		return errors.New("stars expected")
+	}
+	*l = level(len(text))
+	return nil
+}
+
+type Audit struct {
+	Author string
+}
+
+type row struct {
+	Audit
+	ID       int           `csv:"id"`
+	Name     string        `csv:"name"`
+	Price    float64       `csv:"price"`
+	Active   bool          `csv:"active"`
+	Created  time.Time     `csv:"created"`
+	Timeout  time.Duration `csv:"timeout"`
+	Level    level         `csv:"level"`
+	Discount *int          `csv:"discount"`
+	Ignored  string        `csv:"-"`
+	Note     string
+	internal string
+}
+
+func Test_Read(t *testing.T) {
+	data := "id,name,price,active,created,timeout,level,discount,NOTE,author,extra\n" +
+		"1,apple,1.5,true,2024-01-02T03:04:05Z,1m30s,**,10,fresh,ann,x\n" +
+		"2,\"pear, green\",,false,,,,,,,\n"
+
+	rows, err := seqe.Slice(csv.Read[row](strings.NewReader(data), csv.Options{}))
+	assert.NoError(t, err)
+	discount := 10
+	assert.Equal(t, []row{
+		{
+			Audit: Audit{Author: "ann"}, ID: 1, Name: "apple", Price: 1.5, Active: true,
+			Created: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), Timeout: 90 * time.Second,
+			Level: 2, Discount: &discount, Note: "fresh",
+		},
+		{ID: 2, Name: "pear, green"},
+	}, rows)
+}
+
+func Test_Read_Options(t *testing.T) {
+	data := "# comment\nid;created\n7;02.01.2024\n"
+	rows, err := seqe.Slice(csv.Read[row](strings.NewReader(data), csv.Options{Comma: ';', Comment: '#', TimeLayout: "02.01.2006"}))
+	assert.NoError(t, err)
+	assert.Equal(t, []row{{ID: 7, Created: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)}}, rows)
+
+	_, err = seqe.Slice(csv.Read[row](strings.NewReader("id,unknown\n1,2\n"), csv.Options{DisallowUnknownColumns: true}))
+	assert.ErrorIs(t, err, csv.ErrUnknownColumn)
+
+	rows, err = seqe.Slice(csv.Read[row](strings.NewReader(""), csv.Options{}))
+	assert.NoError(t, err)
+	assert.Empty(t, rows)
+
+	_, err = seqe.Slice(csv.Read[int](strings.NewReader("a\n1\n"), csv.Options{}))
+	assert.ErrorIs(t, err, csv.ErrUnsupportedType)
+}
+
+func Test_Read_Errors(t *testing.T) {
+	data := "id,level\n1,*\nx,*\n3,-\n4,*\n"
+
+	var ids []int
+	var errs []error
+	for r, err := range csv.Read[row](strings.NewReader(data), csv.Options{}) {
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			ids = append(ids, r.ID)
+		}
+	}
+	assert.Equal(t, []int{1, 4}, ids)
+	assert.Len(t, errs, 2)
+
+	var csvErr *csv.Error
+	assert.True(t, errors.As(errs[0], &csvErr))
+	assert.Equal(t, 3, csvErr.Line)
+	assert.Equal(t, "id", csvErr.Column)
+	assert.Equal(t, `line 4, column "level": stars expected`, errs[1].Error())
+
+	_, err := seqe.Slice(csv.Read[row](strings.NewReader("id\n1\n2,3\n"), csv.Options{}))
+	assert.ErrorContains(t, err, "line 3")
+}
+
+func Test_ReadRecords(t *testing.T) {
+	records, err := seqe.Slice(csv.ReadRecords(strings.NewReader("a,b\n1,\"2\n3\"\n")))
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"a", "b"}, {"1", "2\n3"}}, records)
+}
+
+func Test_Write(t *testing.T) {
+	discount := 5
+	rows := seq.Of(
+		row{Audit: Audit{Author: "ann"}, ID: 1, Name: "apple, red", Price: 1.5, Active: true,
+			Created: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), Timeout: time.Minute, Level: 3, Discount: &discount},
+		row{ID: 2, Name: "pear", Note: "n", Ignored: "x"},
+	)
+	var out bytes.Buffer
+	assert.NoError(t, csv.Write(&out, seq.Conv(rows, func(r row) (row, error) { return r, nil }), csv.Options{}))
+	assert.Equal(t, "Author,id,name,price,active,created,timeout,level,discount,Note\n"+
+		"ann,1,\"apple, red\",1.5,true,2024-01-02T03:04:05Z,1m0s,***,5,\n"+
+		",2,pear,0,false,0001-01-01T00:00:00Z,0s,,,n\n", out.String())
+
+	decoded, err := seqe.Slice(csv.Read[row](&out, csv.Options{}))
+	assert.NoError(t, err)
+	assert.Equal(t, "apple, red", decoded[0].Name)
+	assert.Equal(t, discount, *decoded[0].Discount)
+	assert.Equal(t, "n", decoded[1].Note)
+}
+
+func Test_Write_Options(t *testing.T) {
+	opts := csv.Options{Comma: ';', TimeLayout: "02.01.2006 15:04"}
+	rows := seq.Of(row{ID: 1, Name: "a,b", Created: time.Date(2024, 1, 2, 3, 4, 0, 0, time.UTC)})
+	var out bytes.Buffer
+	assert.NoError(t, csv.Write(&out, seq.Conv(rows, func(r row) (row, error) { return r, nil }), opts))
+	assert.Equal(t, "Author;id;name;price;active;created;timeout;level;discount;Note\n"+
+		";1;a,b;0;false;02.01.2024 03:04;0s;;;\n", out.String())
+
+	decoded, err := seqe.Slice(csv.Read[row](&out, opts))
+	assert.NoError(t, err)
+	assert.Equal(t, rows.Slice(), decoded)
+}
+
+func Test_Write_Error(t *testing.T) {
+	var out bytes.Buffer
+	failure := errors.New("failure")
+	err := csv.Write(&out, seq.SeqE[row](func(yield func(row, error) bool) {
+		_ = yield(row{ID: 1}, nil) && yield(row{}, failure)
+	}), csv.Options{})
+	assert.ErrorIs(t, err, failure)
+	assert.Equal(t, "line 3: failure", err.Error())
+	assert.Equal(t, 2, strings.Count(out.String(), "\n"))
+}
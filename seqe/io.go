@@ -0,0 +1,88 @@
+package seqe
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/m4gshm/gollections/internal/seqe"
+	"github.com/m4gshm/gollections/seq"
+)
+
+// Lines returns a seq that reads the 'r' reader line by line. The line terminators are stripped.
+// If the reader is an io.Closer it is closed when the iteration completes or is stopped early.
+func Lines(r io.Reader) seq.SeqE[string] {
+	return Scan(r, bufio.ScanLines)
+}
+
+// Scan returns a seq that reads the 'r' reader by tokens retrieved by the 'split' function.
+// A nil 'split' splits by lines. A token cannot be longer than bufio.MaxScanTokenSize.
+// If the reader is an io.Closer it is closed when the iteration completes or is stopped early.
+func Scan(r io.Reader, split bufio.SplitFunc) seq.SeqE[string] {
+	return func(yield func(string, error) bool) {
+		seqe.Closing(r, yield, func() bool {
+			scanner := bufio.NewScanner(r)
+			if split != nil {
+				scanner.Split(split)
+			}
+			for scanner.Scan() {
+				if !yield(scanner.Text(), nil) {
+					return false
+				}
+			}
+			if err := scanner.Err(); err != nil {
+				return yield("", err)
+			}
+			return true
+		})
+	}
+}
+
+// ReadChunks returns a seq that reads the 'r' reader by chunks of the 'size' bytes. The last chunk may be shorter.
+// Every chunk is a new slice, so it can be retained by the consumer.
+// If the reader is an io.Closer it is closed when the iteration completes or is stopped early.
+func ReadChunks(r io.Reader, size int) seq.SeqE[[]byte] {
+	return func(yield func([]byte, error) bool) {
+		seqe.Closing(r, yield, func() bool {
+			if size <= 0 {
+				return yield(nil, fmt.Errorf("invalid chunk size %d", size))
+			}
+			for {
+				chunk := make([]byte, size)
+				n, err := io.ReadFull(r, chunk)
+				if n > 0 && !yield(chunk[:n], nil) {
+					return false
+				}
+				if err == io.EOF || err == io.ErrUnexpectedEOF {
+					return true
+				} else if err != nil {
+					return yield(nil, err)
+				}
+			}
+		})
+	}
+}
+
+// WriteLines writes the strings of the 'seq' sequence to the 'w' writer through a buffer, one string per line.
+// An error of the seq or of the writer is wrapped with the line number, starting from 1, and stops the writing;
+// the lines before it are written anyway.
+func WriteLines[S ~SeqE[string]](w io.Writer, seq S) error {
+	if seq == nil {
+		return nil
+	}
+	out := bufio.NewWriter(w)
+	line := 1
+	for s, err := range seq {
+		if err != nil {
+			_ = out.Flush()
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		if _, err := out.WriteString(s); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		} else if err := out.WriteByte('\n'); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		line++
+	}
+	return out.Flush()
+}
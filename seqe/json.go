@@ -0,0 +1,96 @@
+package seqe
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/m4gshm/gollections/seq"
+)
+
+// DecodeJSONArray returns a seq that reads a JSON array from the 'r' reader and yields its elements one by one
+// without loading the whole array into memory. A JSON null is decoded as an empty sequence.
+// An error is wrapped with the element index and stops the iteration.
+// The reader is consumed, so the seq can be iterated only once. The reader is not closed, it is the caller's responsibility.
+func DecodeJSONArray[T any](r io.Reader) seq.SeqE[T] {
+	return func(yield func(T, error) bool) {
+		var no T
+		dec := json.NewDecoder(r)
+		token, err := dec.Token()
+		if err != nil {
+			yield(no, fmt.Errorf("json array: %w", err))
+			return
+		} else if token == nil {
+			return
+		} else if token != json.Delim('[') {
+			yield(no, fmt.Errorf("json array: unexpected %v", token))
+			return
+		}
+		for i := 0; dec.More(); i++ {
+			var e T
+			if err := dec.Decode(&e); err != nil {
+				yield(no, fmt.Errorf("element %d: %w", i, err))
+				return
+			} else if !yield(e, nil) {
+				return
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			yield(no, fmt.Errorf("json array: %w", err))
+		}
+	}
+}
+
+// DecodeNDJSON returns a seq that reads newline-delimited JSON values from the 'r' reader and yields them one by one.
+// Blank lines are skipped. An error is wrapped with the line number, starting from 1, and stops the iteration.
+// The reader is consumed, so the seq can be iterated only once. The reader is not closed, it is the caller's responsibility.
+func DecodeNDJSON[T any](r io.Reader) seq.SeqE[T] {
+	return func(yield func(T, error) bool) {
+		var no T
+		in := bufio.NewReader(r)
+		for line := 1; ; line++ {
+			data, err := in.ReadBytes('\n')
+			if len(bytes.TrimSpace(data)) > 0 {
+				var e T
+				if err := json.Unmarshal(data, &e); err != nil {
+					yield(no, fmt.Errorf("line %d: %w", line, err))
+					return
+				} else if !yield(e, nil) {
+					return
+				}
+			}
+			if err == io.EOF {
+				return
+			} else if err != nil {
+				yield(no, fmt.Errorf("line %d: %w", line, err))
+				return
+			}
+		}
+	}
+}
+
+// EncodeNDJSON writes the elements of the 'seq' sequence to the 'w' writer as newline-delimited JSON, one element per line.
+// An error of the seq or of the encoding is wrapped with the line number, starting from 1, and stops the writing;
+// the lines before it are written anyway.
+func EncodeNDJSON[S ~SeqE[T], T any](w io.Writer, seq S) error {
+	if seq == nil {
+		return nil
+	}
+	out := bufio.NewWriter(w)
+	enc := json.NewEncoder(out)
+	line := 1
+	for e, err := range seq {
+		if err == nil {
+			err = enc.Encode(e)
+		}
+		if err != nil {
+			// the lines written before are valid, so they are flushed
+			_ = out.Flush()
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		line++
+	}
+	return out.Flush()
+}
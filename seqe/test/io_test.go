@@ -0,0 +1,109 @@
+package test
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/seqe"
+)
+
+type closeTracker struct {
+	io.Reader
+	closed int
+	err    error
+}
+
+func (c *closeTracker) Close() error {
+	c.closed++
+	return c.err
+}
+
+type failingWriter struct{ err error }
+
+func (w failingWriter) Write([]byte) (int, error) {
+	return 0, w.err
+}
+
+func Test_Lines(t *testing.T) {
+	lines, err := seqe.Slice(seqe.Lines(strings.NewReader("a\nb\r\n\nc")))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "", "c"}, lines)
+
+	file := &closeTracker{Reader: strings.NewReader("a\nb\nc\n")}
+	first, ok, err := seqe.Head(seqe.Lines(file))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "a", first)
+	assert.Equal(t, 1, file.closed)
+
+	failure := errors.New("close failure")
+	file = &closeTracker{Reader: strings.NewReader("a\n"), err: failure}
+	lines, err = seqe.Slice(seqe.Lines(file))
+	assert.Equal(t, []string{"a"}, lines)
+	assert.ErrorIs(t, err, failure)
+	assert.Equal(t, 1, file.closed)
+}
+
+func Test_Scan(t *testing.T) {
+	words, err := seqe.Slice(seqe.Scan(strings.NewReader("one two  three"), bufio.ScanWords))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"one", "two", "three"}, words)
+
+	_, err = seqe.Slice(seqe.Scan(strings.NewReader(strings.Repeat("x", bufio.MaxScanTokenSize+1)), nil))
+	assert.ErrorIs(t, err, bufio.ErrTooLong)
+}
+
+func Test_ReadChunks(t *testing.T) {
+	chunks, err := seqe.Slice(seqe.ReadChunks(strings.NewReader("abcdefg"), 3))
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("abc"), []byte("def"), []byte("g")}, chunks)
+
+	chunks, err = seqe.Slice(seqe.ReadChunks(strings.NewReader(""), 3))
+	assert.NoError(t, err)
+	assert.Empty(t, chunks)
+
+	file := &closeTracker{Reader: strings.NewReader("abcdef")}
+	for range seqe.ReadChunks(file, 2) {
+		break
+	}
+	assert.Equal(t, 1, file.closed)
+
+	_, err = seqe.Slice(seqe.ReadChunks(strings.NewReader("a"), 0))
+	assert.Error(t, err)
+}
+
+func Test_WriteLines(t *testing.T) {
+	var out bytes.Buffer
+	assert.NoError(t, seqe.WriteLines(&out, seqe.Lines(strings.NewReader("a\nb"))))
+	assert.Equal(t, "a\nb\n", out.String())
+
+	out.Reset()
+	failure := errors.New("failure")
+	err := seqe.WriteLines(&out, seq.SeqE[string](func(yield func(string, error) bool) {
+		_ = yield("a", nil) && yield("", failure)
+	}))
+	assert.ErrorIs(t, err, failure)
+	assert.ErrorContains(t, err, "line 2:")
+	assert.Equal(t, "a\n", out.String())
+
+	// the long line overflows the write buffer
+	err = seqe.WriteLines(failingWriter{failure}, seq.SeqE[string](func(yield func(string, error) bool) {
+		_ = yield("a", nil) && yield(strings.Repeat("b", 5000), nil)
+	}))
+	assert.ErrorIs(t, err, failure)
+	assert.ErrorContains(t, err, "line 2:")
+
+	// the line fills the write buffer, so the line break fails
+	err = seqe.WriteLines(failingWriter{failure}, seq.SeqE[string](func(yield func(string, error) bool) {
+		_ = yield(strings.Repeat("b", 4096), nil)
+	}))
+	assert.ErrorIs(t, err, failure)
+	assert.ErrorContains(t, err, "line 1:")
+}
@@ -0,0 +1,78 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/convert/as"
+	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/seqe"
+)
+
+type item struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func Test_DecodeJSONArray(t *testing.T) {
+	items, err := seqe.Slice(seqe.DecodeJSONArray[item](strings.NewReader(`[{"id":1,"name":"a"}, {"id":2,"name":"b"}]`)))
+	assert.NoError(t, err)
+	assert.Equal(t, []item{{1, "a"}, {2, "b"}}, items)
+
+	empty, err := seqe.Slice(seqe.DecodeJSONArray[item](strings.NewReader(`null`)))
+	assert.NoError(t, err)
+	assert.Empty(t, empty)
+
+	first, ok, err := seqe.Head(seqe.DecodeJSONArray[int](strings.NewReader(`[1, 2, "broken`)))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 1, first)
+
+	ints, err := seqe.Slice(seqe.DecodeJSONArray[int](strings.NewReader(`[1, 2, "x"]`)))
+	assert.Equal(t, []int{1, 2}, ints)
+	assert.ErrorContains(t, err, "element 2:")
+
+	_, err = seqe.Slice(seqe.DecodeJSONArray[int](strings.NewReader(`{"a":1}`)))
+	assert.Error(t, err)
+
+	file := &closeTracker{Reader: strings.NewReader(`[1]`)}
+	_, err = seqe.Slice(seqe.DecodeJSONArray[int](file))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, file.closed)
+	_, err = seqe.Slice(seqe.DecodeNDJSON[int](file))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, file.closed)
+}
+
+func Test_DecodeNDJSON(t *testing.T) {
+	items, err := seqe.Slice(seqe.DecodeNDJSON[item](strings.NewReader("{\"id\":1,\"name\":\"a\"}\n\n{\"id\":2,\"name\":\"b\"}")))
+	assert.NoError(t, err)
+	assert.Equal(t, []item{{1, "a"}, {2, "b"}}, items)
+
+	ints, err := seqe.Slice(seqe.DecodeNDJSON[int](strings.NewReader("1\n2\n\nx\n5\n")))
+	assert.Equal(t, []int{1, 2}, ints)
+	assert.ErrorContains(t, err, "line 4:")
+}
+
+func Test_EncodeNDJSON(t *testing.T) {
+	var out bytes.Buffer
+	assert.NoError(t, seqe.EncodeNDJSON(&out, seq.Conv(seq.Of(item{1, "a"}, item{2, "b"}), as.ErrTail(as.Is[item]))))
+	assert.Equal(t, "{\"id\":1,\"name\":\"a\"}\n{\"id\":2,\"name\":\"b\"}\n", out.String())
+
+	decoded, err := seqe.Slice(seqe.DecodeNDJSON[item](&out))
+	assert.NoError(t, err)
+	assert.Equal(t, []item{{1, "a"}, {2, "b"}}, decoded)
+
+	out.Reset()
+	failure := errors.New("failure")
+	err = seqe.EncodeNDJSON(&out, seq.SeqE[int](func(yield func(int, error) bool) {
+		_ = yield(1, nil) && yield(0, failure)
+	}))
+	assert.ErrorIs(t, err, failure)
+	assert.ErrorContains(t, err, "line 2:")
+	assert.Equal(t, "1\n", out.String())
+}
@@ -0,0 +1,179 @@
+// Package diff provides computation of edit scripts between slices
+package diff
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrPatchMismatch is returned by ApplyPatch if the edit script does not match the slice
+var ErrPatchMismatch = errors.New("diff: patch does not match the slice")
+
+// Kind is a kind of an edit operation
+type Kind int
+
+const (
+	// Equal keeps an element of the old slice
+	Equal Kind = iota
+	// Insert inserts an element of the updated slice
+	Insert
+	// Delete removes an element of the old slice
+	Delete
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Equal:
+		return "Equal"
+	case Insert:
+		return "Insert"
+	case Delete:
+		return "Delete"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Edit is an operation of an edit script.
+// OldIndex is the index of the element in the old slice, or the position before which the element is inserted.
+// NewIndex is the index of the element in the updated slice, or the position where the deleted element would be.
+// Element is the deleted or the kept element of the old slice, or the inserted element of the updated slice.
+type Edit[T any] struct {
+	Kind     Kind
+	OldIndex int
+	NewIndex int
+	Element  T
+}
+
+// Of computes a minimal edit script that transforms the old slice into the updated one
+func Of[TS ~[]T, T comparable](old, updated TS) []Edit[T] {
+	return By(old, updated, func(a, b T) bool { return a == b })
+}
+
+// By computes a minimal edit script that transforms the old slice into the updated one
+// using the linear space variant of the Myers algorithm.
+// The elements are compared by the 'equal' function.
+func By[TS ~[]T, T any](old, updated TS, equal func(T, T) bool) []Edit[T] {
+	size := 2*((len(old)+len(updated)+1)/2) + 3
+	d := &differ[T]{old: old, updated: updated, equal: equal, forward: make([]int, size), backward: make([]int, size)}
+	d.compare(0, len(old), 0, len(updated))
+	return d.script
+}
+
+type differ[T any] struct {
+	old, updated      []T
+	equal             func(T, T) bool
+	forward, backward []int
+	script            []Edit[T]
+}
+
+// compare appends the edit script of the old[x0:x1] and updated[y0:y1] ranges
+func (d *differ[T]) compare(x0, x1, y0, y1 int) {
+	for x0 < x1 && y0 < y1 && d.equal(d.old[x0], d.updated[y0]) {
+		d.equals(x0, y0, 1)
+		x0, y0 = x0+1, y0+1
+	}
+	suffix := 0
+	for x0 < x1 && y0 < y1 && d.equal(d.old[x1-1], d.updated[y1-1]) {
+		x1, y1 = x1-1, y1-1
+		suffix++
+	}
+	switch {
+	case x0 == x1:
+		for y := y0; y < y1; y++ {
+			d.script = append(d.script, Edit[T]{Kind: Insert, OldIndex: x0, NewIndex: y, Element: d.updated[y]})
+		}
+	case y0 == y1:
+		for x := x0; x < x1; x++ {
+			d.script = append(d.script, Edit[T]{Kind: Delete, OldIndex: x, NewIndex: y0, Element: d.old[x]})
+		}
+	default:
+		fromX, fromY, toX, toY := d.middleSnake(x0, x1, y0, y1)
+		d.compare(x0, fromX, y0, fromY)
+		d.equals(fromX, fromY, toX-fromX)
+		d.compare(toX, x1, toY, y1)
+	}
+	d.equals(x1, y1, suffix)
+}
+
+func (d *differ[T]) equals(x, y, count int) {
+	for i := range count {
+		d.script = append(d.script, Edit[T]{Kind: Equal, OldIndex: x + i, NewIndex: y + i, Element: d.old[x+i]})
+	}
+}
+
+// middleSnake finds the middle snake of an optimal edit path of the ranges by running the search from both ends.
+// The forward and backward buffers hold the furthest reaching x of every diagonal, so the memory is linear.
+func (d *differ[T]) middleSnake(x0, x1, y0, y1 int) (fromX, fromY, toX, toY int) {
+	n, m := x1-x0, y1-y0
+	limit := (n + m + 1) / 2
+	offset := limit + 1
+	forward, backward := d.forward[:2*offset+1], d.backward[:2*offset+1]
+	forward[offset+1], backward[offset+1] = 0, 0
+	delta := n - m
+	odd := delta%2 != 0
+	for step := 0; step <= limit; step++ {
+		for k := -step; k <= step; k += 2 {
+			x := furthest(forward, offset, k, step)
+			y := x - k
+			startX, startY := x, y
+			for x < n && y < m && d.equal(d.old[x0+x], d.updated[y0+y]) {
+				x, y = x+1, y+1
+			}
+			forward[offset+k] = x
+			if back := delta - k; odd && back >= -(step-1) && back <= step-1 && x+backward[offset+back] >= n {
+				return x0 + startX, y0 + startY, x0 + x, y0 + y
+			}
+		}
+		for k := -step; k <= step; k += 2 {
+			x := furthest(backward, offset, k, step)
+			y := x - k
+			startX, startY := x, y
+			for x < n && y < m && d.equal(d.old[x1-x-1], d.updated[y1-y-1]) {
+				x, y = x+1, y+1
+			}
+			backward[offset+k] = x
+			if front := delta - k; !odd && front >= -step && front <= step && x+forward[offset+front] >= n {
+				return x1 - x, y1 - y, x1 - startX, y1 - startY
+			}
+		}
+	}
+	panic("unreachable")
+}
+
+// furthest returns the x the diagonal k is reached at on the step by one insertion or deletion
+func furthest(v []int, offset, k, step int) int {
+	if k == -step || (k != step && v[offset+k-1] < v[offset+k+1]) {
+		return v[offset+k+1]
+	}
+	return v[offset+k-1] + 1
+}
+
+// ApplyPatch replays the edit script on the old slice and returns the updated one.
+// Returns ErrPatchMismatch if the script does not cover the old slice sequentially.
+func ApplyPatch[TS ~[]T, T any](old TS, script []Edit[T]) (TS, error) {
+	out := make(TS, 0, len(old))
+	pos := 0
+	for i, edit := range script {
+		switch edit.Kind {
+		case Insert:
+			if edit.OldIndex != pos {
+				return nil, fmt.Errorf("%w: edit %d: insert position %d, expected %d", ErrPatchMismatch, i, edit.OldIndex, pos)
+			}
+			out = append(out, edit.Element)
+		case Equal, Delete:
+			if edit.OldIndex != pos || pos >= len(old) {
+				return nil, fmt.Errorf("%w: edit %d: %s index %d, expected %d of %d", ErrPatchMismatch, i, edit.Kind, edit.OldIndex, pos, len(old))
+			}
+			if edit.Kind == Equal {
+				out = append(out, old[pos])
+			}
+			pos++
+		default:
+			return nil, fmt.Errorf("%w: edit %d: unknown kind %s", ErrPatchMismatch, i, edit.Kind)
+		}
+	}
+	if pos != len(old) {
+		return nil, fmt.Errorf("%w: %d trailing elements are not covered", ErrPatchMismatch, len(old)-pos)
+	}
+	return out, nil
+}
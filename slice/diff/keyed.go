@@ -0,0 +1,118 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Change is a set of flags that describe how an entity with a key has changed
+type Change int
+
+const (
+	// Added means the entity exists only in the updated slice
+	Added Change = 1 << iota
+	// Removed means the entity exists only in the old slice
+	Removed
+	// Moved means the position of the entity has changed relatively to the other common entities
+	Moved
+	// Changed means the entity is not equal to the old one with the same key
+	Changed
+)
+
+// Has checks whether the change contains all of the flags
+func (c Change) Has(flags Change) bool {
+	return c&flags == flags
+}
+
+func (c Change) String() string {
+	var names []string
+	for _, flag := range []struct {
+		change Change
+		name   string
+	}{{Added, "Added"}, {Removed, "Removed"}, {Moved, "Moved"}, {Changed, "Changed"}} {
+		if c.Has(flag.change) {
+			names = append(names, flag.name)
+		}
+	}
+	if len(names) == 0 {
+		return fmt.Sprintf("Change(%d)", int(c))
+	}
+	return strings.Join(names, "|")
+}
+
+// KeyedEdit describes the change of an entity with a key.
+// OldIndex and Old are set for the removed, moved and changed entities, otherwise OldIndex is -1.
+// NewIndex and New are set for the added, moved and changed entities, otherwise NewIndex is -1.
+type KeyedEdit[K comparable, T any] struct {
+	Change   Change
+	Key      K
+	OldIndex int
+	NewIndex int
+	Old      T
+	New      T
+}
+
+// Keyed compares entities of two slices matching them by keys retrieved by the 'keyExtractor'.
+// The matched entities are compared by the 'equal' function.
+// The result contains the removed entities in the old order, followed by the added, moved and changed entities in the updated order.
+// Unchanged entities are omitted. The keys must be unique within each slice.
+func Keyed[TS ~[]T, T any, K comparable](old, updated TS, keyExtractor func(T) K, equal func(T, T) bool) []KeyedEdit[K, T] {
+	oldIndexes, newIndexes := indexes(old, keyExtractor), indexes(updated, keyExtractor)
+
+	var result []KeyedEdit[K, T]
+	var oldCommon, newCommon []K
+	for i, e := range old {
+		if key := keyExtractor(e); hasKey(newIndexes, key) {
+			oldCommon = append(oldCommon, key)
+		} else {
+			result = append(result, KeyedEdit[K, T]{Change: Removed, Key: key, OldIndex: i, NewIndex: -1, Old: e})
+		}
+	}
+	for _, e := range updated {
+		if key := keyExtractor(e); hasKey(oldIndexes, key) {
+			newCommon = append(newCommon, key)
+		}
+	}
+
+	moved := map[K]struct{}{}
+	for _, edit := range Of(oldCommon, newCommon) {
+		if edit.Kind == Insert {
+			moved[edit.Element] = struct{}{}
+		}
+	}
+
+	for i, e := range updated {
+		key := keyExtractor(e)
+		oldIndex, ok := oldIndexes[key]
+		if !ok {
+			result = append(result, KeyedEdit[K, T]{Change: Added, Key: key, OldIndex: -1, NewIndex: i, New: e})
+			continue
+		}
+		var change Change
+		if hasKey(moved, key) {
+			change |= Moved
+		}
+		if !equal(old[oldIndex], e) {
+			change |= Changed
+		}
+		if change != 0 {
+			result = append(result, KeyedEdit[K, T]{Change: change, Key: key, OldIndex: oldIndex, NewIndex: i, Old: old[oldIndex], New: e})
+		}
+	}
+	return result
+}
+
+func indexes[TS ~[]T, T any, K comparable](elements TS, keyExtractor func(T) K) map[K]int {
+	out := make(map[K]int, len(elements))
+	for i, e := range elements {
+		if key := keyExtractor(e); !hasKey(out, key) {
+			out[key] = i
+		}
+	}
+	return out
+}
+
+func hasKey[K comparable, V any](m map[K]V, key K) bool {
+	_, ok := m[key]
+	return ok
+}
@@ -0,0 +1,172 @@
+package test
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/slice"
+	"github.com/m4gshm/gollections/slice/diff"
+)
+
+func render(script []diff.Edit[string]) string {
+	var out strings.Builder
+	for _, edit := range script {
+		switch edit.Kind {
+		case diff.Equal:
+			out.WriteString(" ")
+		case diff.Insert:
+			out.WriteString("+")
+		case diff.Delete:
+			out.WriteString("-")
+		}
+		out.WriteString(edit.Element)
+	}
+	return out.String()
+}
+
+func Test_Diff_Of(t *testing.T) {
+	old := strings.Split("ABCABBA", "")
+	updated := strings.Split("CBABAC", "")
+
+	script := diff.Of(old, updated)
+	deletes, inserts := 0, 0
+	for _, edit := range script {
+		switch edit.Kind {
+		case diff.Delete:
+			deletes++
+			assert.Equal(t, old[edit.OldIndex], edit.Element)
+		case diff.Insert:
+			inserts++
+			assert.Equal(t, updated[edit.NewIndex], edit.Element)
+		case diff.Equal:
+			assert.Equal(t, old[edit.OldIndex], updated[edit.NewIndex])
+		}
+	}
+	assert.Equal(t, 5, deletes+inserts)
+
+	patched, err := diff.ApplyPatch(old, script)
+	assert.NoError(t, err)
+	assert.Equal(t, updated, patched)
+}
+
+func Test_Diff_Script(t *testing.T) {
+	script := diff.Of(strings.Split("abc", ""), strings.Split("abxc", ""))
+	assert.Equal(t, " a b+x c", render(script))
+	assert.Equal(t, diff.Edit[string]{Kind: diff.Insert, OldIndex: 2, NewIndex: 2, Element: "x"}, script[2])
+
+	assert.Equal(t, "-a-b", render(diff.Of(strings.Split("ab", ""), nil)))
+	assert.Equal(t, "+a", render(diff.Of(nil, []string{"a"})))
+	assert.Empty(t, diff.Of[[]string](nil, nil))
+}
+
+func Test_Diff_By(t *testing.T) {
+	script := diff.By(slice.Of("A", "b"), slice.Of("a", "B", "c"), strings.EqualFold)
+	assert.Equal(t, []diff.Kind{diff.Equal, diff.Equal, diff.Insert}, slice.Convert(script, func(e diff.Edit[string]) diff.Kind { return e.Kind }))
+}
+
+func Test_ApplyPatch_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := func() []int {
+		out := make([]int, r.Intn(20))
+		for i := range out {
+			out[i] = r.Intn(5)
+		}
+		return out
+	}
+	for range 200 {
+		old, updated := random(), random()
+		patched, err := diff.ApplyPatch(old, diff.Of(old, updated))
+		assert.NoError(t, err)
+		assert.Equal(t, len(updated), len(patched))
+		for i := range updated {
+			assert.Equal(t, updated[i], patched[i])
+		}
+	}
+}
+
+func Test_Diff_Minimal(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	random := func() []int {
+		out := make([]int, r.Intn(30))
+		for i := range out {
+			out[i] = r.Intn(4)
+		}
+		return out
+	}
+	for range 200 {
+		old, updated := random(), random()
+		changes := 0
+		for _, edit := range diff.Of(old, updated) {
+			if edit.Kind != diff.Equal {
+				changes++
+			}
+		}
+		assert.Equal(t, len(old)+len(updated)-2*lcs(old, updated), changes)
+	}
+}
+
+func lcs(a, b []int) int {
+	prev, cur := make([]int, len(b)+1), make([]int, len(b)+1)
+	for i := range a {
+		for j := range b {
+			if a[i] == b[j] {
+				cur[j+1] = prev[j] + 1
+			} else {
+				cur[j+1] = max(prev[j+1], cur[j])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func Test_Diff_Large(t *testing.T) {
+	const size = 5000
+	old, updated := make([]int, size), make([]int, size)
+	for i := range size {
+		old[i], updated[i] = i, -i-1
+	}
+	old[size/2] = updated[size/3]
+
+	script := diff.Of(old, updated)
+	assert.Equal(t, 2*size-1, len(script))
+	patched, err := diff.ApplyPatch(old, script)
+	assert.NoError(t, err)
+	assert.Equal(t, updated, patched)
+}
+
+func Test_ApplyPatch_Mismatch(t *testing.T) {
+	script := diff.Of(slice.Of(1, 2, 3), slice.Of(1, 3))
+
+	_, err := diff.ApplyPatch(slice.Of(1, 2), script)
+	assert.True(t, errors.Is(err, diff.ErrPatchMismatch))
+
+	_, err = diff.ApplyPatch(slice.Of(1, 2, 3, 4), script)
+	assert.True(t, errors.Is(err, diff.ErrPatchMismatch))
+}
+
+type entity struct {
+	id    int
+	value string
+}
+
+func Test_Keyed(t *testing.T) {
+	old := []entity{{1, "a"}, {2, "b"}, {3, "c"}, {4, "d"}}
+	updated := []entity{{3, "c"}, {1, "a"}, {2, "B"}, {5, "e"}}
+
+	edits := diff.Keyed(old, updated, func(e entity) int { return e.id }, func(a, b entity) bool { return a == b })
+
+	assert.Equal(t, []diff.KeyedEdit[int, entity]{
+		{Change: diff.Removed, Key: 4, OldIndex: 3, NewIndex: -1, Old: entity{4, "d"}},
+		{Change: diff.Moved, Key: 3, OldIndex: 2, NewIndex: 0, Old: entity{3, "c"}, New: entity{3, "c"}},
+		{Change: diff.Changed, Key: 2, OldIndex: 1, NewIndex: 2, Old: entity{2, "b"}, New: entity{2, "B"}},
+		{Change: diff.Added, Key: 5, OldIndex: -1, NewIndex: 3, New: entity{5, "e"}},
+	}, edits)
+
+	assert.Equal(t, "Moved|Changed", (diff.Moved | diff.Changed).String())
+	assert.True(t, (diff.Moved | diff.Changed).Has(diff.Changed))
+}
@@ -0,0 +1,152 @@
+package slice
+
+import "github.com/m4gshm/gollections/convert"
+
+// Dedup returns distinct elements in the order of their first occurrence
+func Dedup[TS ~[]T, T comparable](elements TS) TS {
+	return DedupBy(elements, convert.AsIs[T])
+}
+
+// AppendDedup adds distinct elements of the 'src' to the 'dest' in the order of their first occurrence
+func AppendDedup[TS ~[]T, DS ~[]T, T comparable](src TS, dest DS) DS {
+	return AppendDedupBy(src, dest, convert.AsIs[T])
+}
+
+// DedupBy returns elements with distinct keys extracted by the 'keyExtractor' in the order of their first occurrence
+func DedupBy[TS ~[]T, T any, K comparable](elements TS, keyExtractor func(T) K) TS {
+	if elements == nil {
+		return nil
+	}
+	return AppendDedupBy(elements, make(TS, 0, len(elements)), keyExtractor)
+}
+
+// AppendDedupBy adds elements of the 'src' with distinct keys extracted by the 'keyExtractor' to the 'dest' in the order of their first occurrence
+func AppendDedupBy[TS ~[]T, DS ~[]T, T any, K comparable](src TS, dest DS, keyExtractor func(T) K) DS {
+	return appendExcluded(src, dest, keyExtractor, make(map[K]struct{}, len(src)))
+}
+
+// Union returns distinct elements of both slices, the elements of the first slice go first
+func Union[TS ~[]T, T comparable](first, second TS) TS {
+	return UnionBy(first, second, convert.AsIs[T])
+}
+
+// AppendUnion adds distinct elements of both slices to the 'dest', the elements of the first slice go first
+func AppendUnion[TS ~[]T, DS ~[]T, T comparable](first, second TS, dest DS) DS {
+	return AppendUnionBy(first, second, dest, convert.AsIs[T])
+}
+
+// UnionBy returns elements of both slices with distinct keys extracted by the 'keyExtractor', the elements of the first slice go first
+func UnionBy[TS ~[]T, T any, K comparable](first, second TS, keyExtractor func(T) K) TS {
+	if first == nil && second == nil {
+		return nil
+	}
+	return AppendUnionBy(first, second, make(TS, 0, len(first)+len(second)), keyExtractor)
+}
+
+// AppendUnionBy adds elements of both slices with distinct keys extracted by the 'keyExtractor' to the 'dest', the elements of the first slice go first
+func AppendUnionBy[TS ~[]T, DS ~[]T, T any, K comparable](first, second TS, dest DS, keyExtractor func(T) K) DS {
+	excluded := make(map[K]struct{}, len(first)+len(second))
+	dest = appendExcluded(first, dest, keyExtractor, excluded)
+	return appendExcluded(second, dest, keyExtractor, excluded)
+}
+
+// Intersect returns distinct elements of the first slice that are contained in the second one
+func Intersect[TS ~[]T, T comparable](first, second TS) TS {
+	return IntersectBy(first, second, convert.AsIs[T])
+}
+
+// AppendIntersect adds distinct elements of the first slice that are contained in the second one to the 'dest'
+func AppendIntersect[TS ~[]T, DS ~[]T, T comparable](first, second TS, dest DS) DS {
+	return AppendIntersectBy(first, second, dest, convert.AsIs[T])
+}
+
+// IntersectBy returns elements of the first slice with distinct keys that are contained in the second one. The keys are extracted by the 'keyExtractor'
+func IntersectBy[TS ~[]T, T any, K comparable](first, second TS, keyExtractor func(T) K) TS {
+	if first == nil {
+		return nil
+	}
+	return AppendIntersectBy(first, second, make(TS, 0, min(len(first), len(second))), keyExtractor)
+}
+
+// AppendIntersectBy adds elements of the first slice with distinct keys that are contained in the second one to the 'dest'. The keys are extracted by the 'keyExtractor'
+func AppendIntersectBy[TS ~[]T, DS ~[]T, T any, K comparable](first, second TS, dest DS, keyExtractor func(T) K) DS {
+	included := keys(second, keyExtractor)
+	for _, e := range first {
+		key := keyExtractor(e)
+		if _, ok := included[key]; ok {
+			delete(included, key)
+			dest = append(dest, e)
+		}
+	}
+	return dest
+}
+
+// Difference returns distinct elements of the first slice that are not contained in the second one
+func Difference[TS ~[]T, T comparable](first, second TS) TS {
+	return DifferenceBy(first, second, convert.AsIs[T])
+}
+
+// AppendDifference adds distinct elements of the first slice that are not contained in the second one to the 'dest'
+func AppendDifference[TS ~[]T, DS ~[]T, T comparable](first, second TS, dest DS) DS {
+	return AppendDifferenceBy(first, second, dest, convert.AsIs[T])
+}
+
+// DifferenceBy returns elements of the first slice with distinct keys that are not contained in the second one. The keys are extracted by the 'keyExtractor'
+func DifferenceBy[TS ~[]T, T any, K comparable](first, second TS, keyExtractor func(T) K) TS {
+	if first == nil {
+		return nil
+	}
+	return AppendDifferenceBy(first, second, make(TS, 0, len(first)), keyExtractor)
+}
+
+// AppendDifferenceBy adds elements of the first slice with distinct keys that are not contained in the second one to the 'dest'. The keys are extracted by the 'keyExtractor'
+func AppendDifferenceBy[TS ~[]T, DS ~[]T, T any, K comparable](first, second TS, dest DS, keyExtractor func(T) K) DS {
+	return appendExcluded(first, dest, keyExtractor, keys(second, keyExtractor))
+}
+
+// SymmetricDifference returns distinct elements that are contained in only one of the slices, the elements of the first slice go first
+func SymmetricDifference[TS ~[]T, T comparable](first, second TS) TS {
+	return SymmetricDifferenceBy(first, second, convert.AsIs[T])
+}
+
+// AppendSymmetricDifference adds distinct elements that are contained in only one of the slices to the 'dest', the elements of the first slice go first
+func AppendSymmetricDifference[TS ~[]T, DS ~[]T, T comparable](first, second TS, dest DS) DS {
+	return AppendSymmetricDifferenceBy(first, second, dest, convert.AsIs[T])
+}
+
+// SymmetricDifferenceBy returns elements with distinct keys that are contained in only one of the slices, the elements of the first slice go first.
+// The keys are extracted by the 'keyExtractor'
+func SymmetricDifferenceBy[TS ~[]T, T any, K comparable](first, second TS, keyExtractor func(T) K) TS {
+	if first == nil && second == nil {
+		return nil
+	}
+	return AppendSymmetricDifferenceBy(first, second, make(TS, 0, len(first)+len(second)), keyExtractor)
+}
+
+// AppendSymmetricDifferenceBy adds elements with distinct keys that are contained in only one of the slices to the 'dest', the elements of the first slice go first.
+// The keys are extracted by the 'keyExtractor'
+func AppendSymmetricDifferenceBy[TS ~[]T, DS ~[]T, T any, K comparable](first, second TS, dest DS, keyExtractor func(T) K) DS {
+	firstKeys, secondKeys := keys(first, keyExtractor), keys(second, keyExtractor)
+	dest = appendExcluded(first, dest, keyExtractor, secondKeys)
+	return appendExcluded(second, dest, keyExtractor, firstKeys)
+}
+
+// appendExcluded adds the elements whose keys are not in the 'excluded' set to the 'dest' and marks the keys as excluded
+func appendExcluded[TS ~[]T, DS ~[]T, T any, K comparable](src TS, dest DS, keyExtractor func(T) K, excluded map[K]struct{}) DS {
+	for _, e := range src {
+		key := keyExtractor(e)
+		if _, ok := excluded[key]; !ok {
+			excluded[key] = struct{}{}
+			dest = append(dest, e)
+		}
+	}
+	return dest
+}
+
+func keys[TS ~[]T, T any, K comparable](elements TS, keyExtractor func(T) K) map[K]struct{} {
+	out := make(map[K]struct{}, len(elements))
+	for _, e := range elements {
+		out[keyExtractor(e)] = struct{}{}
+	}
+	return out
+}
@@ -0,0 +1,136 @@
+// Package sorted provides search and modification operations of slices sorted by a comparer
+package sorted
+
+import (
+	"slices"
+
+	"github.com/m4gshm/gollections/slice"
+)
+
+// BinarySearch searches for the target in the sorted elements and returns the position where the target is found,
+// or the position where the target would appear in the sort order, and a bool saying whether the target is really found.
+func BinarySearch[TS ~[]T, T any](elements TS, target T, comparer slice.Comparer[T]) (int, bool) {
+	return slices.BinarySearchFunc(elements, target, comparer)
+}
+
+// LowerBound returns the index of the first element that is not less than the target, or the length of the elements if there is no such element
+func LowerBound[TS ~[]T, T any](elements TS, target T, comparer slice.Comparer[T]) int {
+	index, _ := BinarySearch(elements, target, comparer)
+	return index
+}
+
+// UpperBound returns the index of the first element that is greater than the target, or the length of the elements if there is no such element
+func UpperBound[TS ~[]T, T any](elements TS, target T, comparer slice.Comparer[T]) int {
+	low, high := 0, len(elements)
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if comparer(elements[mid], target) <= 0 {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low
+}
+
+// EqualRange returns the range [from, to) of the elements that are equal to the target
+func EqualRange[TS ~[]T, T any](elements TS, target T, comparer slice.Comparer[T]) (from, to int) {
+	from = LowerBound(elements, target, comparer)
+	to = from + UpperBound(elements[from:], target, comparer)
+	return from, to
+}
+
+// InsertSorted inserts the element into the sorted elements keeping the sort order.
+// The element is placed after the equal ones, so the insertion is stable.
+func InsertSorted[TS ~[]T, T any](elements TS, element T, comparer slice.Comparer[T]) TS {
+	return slices.Insert(elements, UpperBound(elements, element, comparer), element)
+}
+
+// DeleteSorted removes the first element that is equal to the target from the sorted elements.
+// Returns false if there is no such element.
+func DeleteSorted[TS ~[]T, T any](elements TS, target T, comparer slice.Comparer[T]) (TS, bool) {
+	index, ok := BinarySearch(elements, target, comparer)
+	if !ok {
+		return elements, false
+	}
+	return slices.Delete(elements, index, index+1), true
+}
+
+// Merge combines two sorted slices into a new sorted one keeping all elements.
+// Equal elements of the first slice precede the ones of the second.
+func Merge[TS ~[]T, T any](first, second TS, comparer slice.Comparer[T]) TS {
+	out := make(TS, 0, len(first)+len(second))
+	i, j := 0, 0
+	for i < len(first) && j < len(second) {
+		if comparer(second[j], first[i]) < 0 {
+			out = append(out, second[j])
+			j++
+		} else {
+			out = append(out, first[i])
+			i++
+		}
+	}
+	out = append(out, first[i:]...)
+	return append(out, second[j:]...)
+}
+
+// Union returns a new sorted slice that contains elements of both sorted slices.
+// Equal elements of the slices are matched pairwise, and only the element of the first slice is retained for the matched pair.
+func Union[TS ~[]T, T any](first, second TS, comparer slice.Comparer[T]) TS {
+	out := make(TS, 0, max(len(first), len(second)))
+	i, j := 0, 0
+	for i < len(first) && j < len(second) {
+		switch c := comparer(first[i], second[j]); {
+		case c < 0:
+			out = append(out, first[i])
+			i++
+		case c > 0:
+			out = append(out, second[j])
+			j++
+		default:
+			out = append(out, first[i])
+			i++
+			j++
+		}
+	}
+	out = append(out, first[i:]...)
+	return append(out, second[j:]...)
+}
+
+// Intersect returns a new sorted slice that contains elements of the first sorted slice that are matched pairwise with equal elements of the second one
+func Intersect[TS ~[]T, T any](first, second TS, comparer slice.Comparer[T]) TS {
+	var out TS
+	i, j := 0, 0
+	for i < len(first) && j < len(second) {
+		switch c := comparer(first[i], second[j]); {
+		case c < 0:
+			i++
+		case c > 0:
+			j++
+		default:
+			out = append(out, first[i])
+			i++
+			j++
+		}
+	}
+	return out
+}
+
+// Difference returns a new sorted slice that contains elements of the first sorted slice that are not matched pairwise with equal elements of the second one
+func Difference[TS ~[]T, T any](first, second TS, comparer slice.Comparer[T]) TS {
+	var out TS
+	i, j := 0, 0
+	for i < len(first) && j < len(second) {
+		switch c := comparer(first[i], second[j]); {
+		case c < 0:
+			out = append(out, first[i])
+			i++
+		case c > 0:
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	return append(out, first[i:]...)
+}
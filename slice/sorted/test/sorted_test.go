@@ -0,0 +1,76 @@
+package test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/slice"
+	"github.com/m4gshm/gollections/slice/sorted"
+)
+
+type pair struct {
+	key   int
+	value string
+}
+
+func byKey(a, b pair) int { return cmp.Compare(a.key, b.key) }
+
+func Test_BinarySearch(t *testing.T) {
+	elements := slice.Of(1, 3, 3, 3, 5, 7)
+
+	index, ok := sorted.BinarySearch(elements, 3, cmp.Compare[int])
+	assert.True(t, ok)
+	assert.Equal(t, 1, index)
+
+	index, ok = sorted.BinarySearch(elements, 4, cmp.Compare[int])
+	assert.False(t, ok)
+	assert.Equal(t, 4, index)
+
+	assert.Equal(t, 1, sorted.LowerBound(elements, 3, cmp.Compare[int]))
+	assert.Equal(t, 4, sorted.UpperBound(elements, 3, cmp.Compare[int]))
+	assert.Equal(t, 6, sorted.UpperBound(elements, 7, cmp.Compare[int]))
+	assert.Equal(t, 0, sorted.LowerBound([]int(nil), 1, cmp.Compare[int]))
+
+	from, to := sorted.EqualRange(elements, 3, cmp.Compare[int])
+	assert.Equal(t, 1, from)
+	assert.Equal(t, 4, to)
+	from, to = sorted.EqualRange(elements, 6, cmp.Compare[int])
+	assert.Equal(t, from, to)
+}
+
+func Test_InsertDeleteSorted(t *testing.T) {
+	elements := []pair{{1, "a"}, {2, "b"}, {3, "c"}}
+
+	elements = sorted.InsertSorted(elements, pair{2, "d"}, byKey)
+	elements = sorted.InsertSorted(elements, pair{0, "e"}, byKey)
+	elements = sorted.InsertSorted(elements, pair{4, "f"}, byKey)
+	assert.Equal(t, []pair{{0, "e"}, {1, "a"}, {2, "b"}, {2, "d"}, {3, "c"}, {4, "f"}}, elements)
+
+	elements, ok := sorted.DeleteSorted(elements, pair{key: 2}, byKey)
+	assert.True(t, ok)
+	assert.Equal(t, []pair{{0, "e"}, {1, "a"}, {2, "d"}, {3, "c"}, {4, "f"}}, elements)
+
+	_, ok = sorted.DeleteSorted(elements, pair{key: 5}, byKey)
+	assert.False(t, ok)
+}
+
+func Test_Merge(t *testing.T) {
+	first := []pair{{1, "a"}, {2, "a"}, {4, "a"}}
+	second := []pair{{2, "b"}, {3, "b"}, {5, "b"}}
+
+	assert.Equal(t, []pair{{1, "a"}, {2, "a"}, {2, "b"}, {3, "b"}, {4, "a"}, {5, "b"}}, sorted.Merge(first, second, byKey))
+	assert.Equal(t, []pair{{1, "a"}, {2, "a"}, {3, "b"}, {4, "a"}, {5, "b"}}, sorted.Union(first, second, byKey))
+	assert.Equal(t, []pair{{2, "a"}}, sorted.Intersect(first, second, byKey))
+	assert.Equal(t, []pair{{1, "a"}, {4, "a"}}, sorted.Difference(first, second, byKey))
+}
+
+func Test_Duplicates(t *testing.T) {
+	first, second := slice.Of(1, 1, 1, 2), slice.Of(1, 1, 3)
+
+	assert.Equal(t, slice.Of(1, 1, 1, 2, 3), sorted.Union(first, second, cmp.Compare[int]))
+	assert.Equal(t, slice.Of(1, 1), sorted.Intersect(first, second, cmp.Compare[int]))
+	assert.Equal(t, slice.Of(1, 2), sorted.Difference(first, second, cmp.Compare[int]))
+	assert.Empty(t, sorted.Intersect(first, nil, cmp.Compare[int]))
+}
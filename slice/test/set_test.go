@@ -0,0 +1,50 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m4gshm/gollections/slice"
+)
+
+type user struct {
+	id   int
+	name string
+}
+
+func userID(u user) int { return u.id }
+
+func Test_Dedup(t *testing.T) {
+	assert.Equal(t, slice.Of("b", "a", "c"), slice.Dedup(slice.Of("b", "a", "b", "c", "a")))
+	assert.Nil(t, slice.Dedup[[]string](nil))
+	assert.Equal(t, []user{{1, "a"}, {2, "b"}}, slice.DedupBy([]user{{1, "a"}, {2, "b"}, {1, "c"}}, userID))
+	assert.Equal(t, slice.Of(0, 2, 1), slice.AppendDedup(slice.Of(2, 1, 2), slice.Of(0)))
+}
+
+func Test_Union(t *testing.T) {
+	assert.Equal(t, slice.Of("a", "b", "c", "d"), slice.Union(slice.Of("a", "b", "a"), slice.Of("c", "b", "d")))
+	assert.Equal(t, []user{{1, "a"}, {2, "b"}, {3, "c"}}, slice.UnionBy([]user{{1, "a"}, {2, "b"}}, []user{{2, "x"}, {3, "c"}}, userID))
+	assert.Equal(t, slice.Of(0, 1, 2), slice.AppendUnion(slice.Of(1), slice.Of(2, 1), slice.Of(0)))
+	assert.Nil(t, slice.Union[[]int](nil, nil))
+}
+
+func Test_Intersect(t *testing.T) {
+	assert.Equal(t, slice.Of("c", "a"), slice.Intersect(slice.Of("c", "b", "a", "c"), slice.Of("a", "c", "d")))
+	assert.Equal(t, []user{{2, "b"}}, slice.IntersectBy([]user{{1, "a"}, {2, "b"}}, []user{{2, "x"}, {3, "c"}}, userID))
+	assert.Empty(t, slice.Intersect(slice.Of(1, 2), nil))
+	assert.Equal(t, slice.Of(0, 2), slice.AppendIntersect(slice.Of(1, 2), slice.Of(2, 3), slice.Of(0)))
+}
+
+func Test_Difference(t *testing.T) {
+	assert.Equal(t, slice.Of("c", "a"), slice.Difference(slice.Of("c", "b", "a", "c"), slice.Of("b", "d")))
+	assert.Equal(t, []user{{1, "a"}}, slice.DifferenceBy([]user{{1, "a"}, {2, "b"}}, []user{{2, "x"}}, userID))
+	assert.Equal(t, slice.Of(1, 2), slice.Difference(slice.Of(1, 2), nil))
+	assert.Equal(t, slice.Of(0, 1), slice.AppendDifference(slice.Of(1, 2), slice.Of(2, 3), slice.Of(0)))
+}
+
+func Test_SymmetricDifference(t *testing.T) {
+	assert.Equal(t, slice.Of(1, 4, 5), slice.SymmetricDifference(slice.Of(1, 2, 3, 1), slice.Of(3, 4, 2, 5, 4)))
+	assert.Equal(t, []user{{1, "a"}, {3, "c"}}, slice.SymmetricDifferenceBy([]user{{1, "a"}, {2, "b"}}, []user{{2, "x"}, {3, "c"}}, userID))
+	assert.Equal(t, slice.Of(0, 1, 3), slice.AppendSymmetricDifference(slice.Of(1, 2), slice.Of(2, 3), slice.Of(0)))
+}